@@ -11,9 +11,11 @@ import (
 )
 
 type ClientChat struct {
-	ch    *amqp.Channel
-	queue *amqp.Queue
-	msgs  <-chan amqp.Delivery
+	ch        *amqp.Channel
+	queue     *amqp.Queue
+	msgs      <-chan amqp.Delivery
+	roleChat  string
+	ghostChat string
 }
 
 func createChat() *ClientChat {
@@ -24,7 +26,14 @@ func createChat() *ClientChat {
 }
 
 func (c *Client) getRoleExchangeName() string {
+	if c.isSpectator() {
+		return ""
+	}
 	self := c.roomInfo.Players[c.getMyId()]
+	if self.Role == mafia_connection.Role_DON {
+		// The don talks with the rest of the mafia
+		return strconv.FormatUint(c.roomInfo.RoomID, 10) + mafia_connection.Role_MAFIA.String()
+	}
 	if self.Role != mafia_connection.Role_CIVILIAN && self.Role != mafia_connection.Role_UNKNOWN {
 		return strconv.FormatUint(c.roomInfo.RoomID, 10) + self.Role.String()
 	} else {
@@ -36,6 +45,21 @@ func (c *Client) getCommonExchangeName() string {
 	return strconv.FormatUint(c.roomInfo.RoomID, 10)
 }
 
+func (c *Client) getGhostExchangeName() string {
+	return strconv.FormatUint(c.roomInfo.RoomID, 10) + GHOST_CHAT
+}
+
+func (c *Client) isGhost() bool {
+	if c.isSpectator() {
+		return true
+	}
+	state := c.roomInfo.State
+	if state != mafia_connection.State_NIGHT && state != mafia_connection.State_DAY {
+		return false
+	}
+	return !c.roomInfo.Players[c.getMyId()].Alive
+}
+
 func (c *Client) initChat() error {
 	q, err := c.chat.ch.QueueDeclare(
 		"",    // name
@@ -88,16 +112,16 @@ func (c *Client) initChat() error {
 		return err
 	}
 
+	if c.isGhost() {
+		return c.addGhostChat()
+	}
+
 	return nil
 }
 
-func (c *Client) addRoleChat() error {
-	roleChat := c.getRoleExchangeName()
-	if roleChat == "" {
-		return nil
-	}
+func (c *Client) bindExchange(exchange string) error {
 	err := c.chat.ch.ExchangeDeclare(
-		roleChat, // name
+		exchange, // name
 		"fanout", // type
 		true,     // durable
 		false,    // auto-deleted
@@ -109,16 +133,67 @@ func (c *Client) addRoleChat() error {
 		return err
 	}
 
-	err = c.chat.ch.QueueBind(
+	return c.chat.ch.QueueBind(
 		c.chat.queue.Name, // queue name
 		"",                // routing key
-		roleChat,          // exchange
+		exchange,          // exchange
 		false,
 		nil,
 	)
-	if err != nil {
+}
+
+func (c *Client) unbindExchange(exchange string) error {
+	return c.chat.ch.QueueUnbind(
+		c.chat.queue.Name, // queue name
+		"",                // routing key
+		exchange,          // exchange
+		nil,
+	)
+}
+
+func (c *Client) addRoleChat() error {
+	roleChat := c.getRoleExchangeName()
+	if roleChat == "" {
+		return nil
+	}
+	if err := c.bindExchange(roleChat); err != nil {
+		return err
+	}
+	c.chat.roleChat = roleChat
+	return nil
+}
+
+func (c *Client) removeRoleChat() error {
+	if c.chat.roleChat == "" {
+		return nil
+	}
+	if err := c.unbindExchange(c.chat.roleChat); err != nil {
+		return err
+	}
+	c.chat.roleChat = ""
+	return nil
+}
+
+func (c *Client) addGhostChat() error {
+	if c.chat.queue == nil || c.chat.ghostChat != "" {
+		return nil
+	}
+	ghostChat := c.getGhostExchangeName()
+	if err := c.bindExchange(ghostChat); err != nil {
+		return err
+	}
+	c.chat.ghostChat = ghostChat
+	return nil
+}
+
+func (c *Client) removeGhostChat() error {
+	if c.chat.ghostChat == "" {
+		return nil
+	}
+	if err := c.unbindExchange(c.chat.ghostChat); err != nil {
 		return err
 	}
+	c.chat.ghostChat = ""
 	return nil
 }
 
@@ -130,7 +205,18 @@ func (c *Client) sendMessage(message string) error {
 
 	var err error
 
-	if c.roomInfo.State == mafia_connection.State_NIGHT {
+	if c.isGhost() {
+		err = c.chat.ch.PublishWithContext(ctx,
+			c.getGhostExchangeName(), // exchange
+			"",                       // routing key
+			false,                    // mandatory
+			false,                    // immediate
+			amqp.Publishing{
+				ContentType: "text/plain",
+				Body:        []byte("[" + GHOST_CHAT + "] " + body),
+			},
+		)
+	} else if c.roomInfo.State == mafia_connection.State_NIGHT {
 		err = c.chat.ch.PublishWithContext(ctx,
 			c.getRoleExchangeName(), // exchange
 			"",                      // routing key
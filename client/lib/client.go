@@ -7,13 +7,16 @@ import (
 	"io"
 	"log"
 	"mafia/client/lib/cli"
+	"mafia/game"
 	mafia_connection "mafia/protos"
 	"mafia/utils"
 	"math/rand"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/c-bata/go-prompt"
+	"github.com/golang/protobuf/ptypes/empty"
 	amqp "github.com/rabbitmq/amqp091-go"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -22,16 +25,19 @@ import (
 type Config struct {
 	ServerAddr    string `config:"server-addr"`
 	RabbitmqCreds string `config:"rabbitmq-creds"`
+	RoomCode      string `config:"room-code"`
 }
 
 type Option struct {
-	chat   bool
-	rand   bool
-	action *mafia_connection.PlayerAction
+	chat    bool
+	rand    bool
+	control bool
+	action  *mafia_connection.PlayerAction
 }
 
 type Client struct {
 	nickname string
+	config   Config
 
 	possibleOptions map[string]Option
 	roomInfo        *mafia_connection.RoomInfo
@@ -42,6 +48,8 @@ type Client struct {
 	prompt     *prompt.Prompt
 	grpcClient mafia_connection.MafiaServiceClient
 	stream     mafia_connection.MafiaService_RouteGameClient
+	session    string
+	ctx        context.Context
 }
 
 func (c *Client) Clear() {
@@ -49,16 +57,18 @@ func (c *Client) Clear() {
 		delete(c.possibleOptions, k)
 	}
 	c.roomInfo = nil
+	c.session = ""
 	c.chat = createChat()
 	cl, p := cli.GetCli()
 	c.cli = cl
 	c.prompt = p
 }
 
-func GetClient() *Client {
+func GetClient(config Config) *Client {
 	c, p := cli.GetCli()
 	return &Client{
 		nickname:        utils.GenerateNickname(),
+		config:          config,
 		possibleOptions: make(map[string]Option),
 		roomInfo:        nil,
 		mux:             sync.Mutex{},
@@ -77,6 +87,10 @@ func (c *Client) getMyId() int {
 	return -1
 }
 
+func (c *Client) isSpectator() bool {
+	return c.getMyId() == -1
+}
+
 func (c *Client) printRoomInfo() {
 	if c.roomInfo == nil {
 		return
@@ -84,12 +98,44 @@ func (c *Client) printRoomInfo() {
 	border := strings.Repeat("-", 75)
 	roomInfo := []string{border}
 	roomInfo = append(roomInfo, fmt.Sprintf(
-		"Room: '%d', State: %s, Ctrl + D to leave",
+		"Room: '%d', Rules: %s, State: %s, Players: %d/%d, Ctrl + D to leave",
 		c.roomInfo.RoomID,
+		c.roomInfo.Rules,
 		c.roomInfo.State.String(),
+		len(c.roomInfo.Players),
+		c.roomInfo.MaxPlayers,
 	))
-	myId := c.getMyId()
-	for id, player := range c.roomInfo.Players {
+	if timeLeft := c.getTimeLeft(); timeLeft != "" {
+		roomInfo = append(roomInfo, timeLeft)
+	}
+	if len(c.roomInfo.Runoff) > 0 {
+		runoff := make([]string, len(c.roomInfo.Runoff))
+		for i, user := range c.roomInfo.Runoff {
+			runoff[i] = user.Nickname
+		}
+		roomInfo = append(roomInfo, "Runoff: "+strings.Join(runoff, ", "))
+	}
+	if c.roomInfo.Speaker != nil {
+		roomInfo = append(roomInfo, "Speaker: "+c.roomInfo.Speaker.Nickname)
+	}
+	if len(c.roomInfo.Nominees) > 0 {
+		nominees := make([]string, len(c.roomInfo.Nominees))
+		for i, user := range c.roomInfo.Nominees {
+			nominees[i] = user.Nickname
+		}
+		roomInfo = append(roomInfo, "Nominees: "+strings.Join(nominees, ", "))
+	}
+	roomInfo = append(roomInfo, formatPlayers(c.roomInfo, c.getMyId(), c.nickname)...)
+	roomInfo = append(roomInfo, formatVotes(c.roomInfo)...)
+	roomInfo = append(roomInfo, border)
+	for _, s := range roomInfo {
+		c.cli.Println(s)
+	}
+}
+
+func formatPlayers(info *mafia_connection.RoomInfo, myId int, nickname string) []string {
+	lines := make([]string, 0, len(info.Players)+1)
+	for id, player := range info.Players {
 		isMe := ""
 		if id == myId {
 			isMe = "(You)"
@@ -98,23 +144,171 @@ func (c *Client) printRoomInfo() {
 		if !player.Alive {
 			status = "ghost"
 		}
-		roomInfo = append(roomInfo, fmt.Sprintf(
-			"%s%s (Role: %s, Status: %s)",
+		markers := ""
+		if player.Bot {
+			markers = "[bot]"
+		}
+		if info.Host != nil && info.Host.ID == player.User.ID {
+			markers += "[host]"
+		}
+		if info.State == mafia_connection.State_NOT_STARTED && player.Ready {
+			markers += "[ready]"
+		}
+		role := player.Role.String()
+		if player.Role == mafia_connection.Role_UNKNOWN && player.Faction != "" {
+			role = fmt.Sprintf("%s, Faction: %s", role, player.Faction)
+		}
+		lines = append(lines, fmt.Sprintf(
+			"%2d. %s%s%s (Role: %s, Status: %s)",
+			player.Seat,
 			isMe,
 			player.User.Nickname,
-			player.Role.String(),
+			markers,
+			role,
 			status,
 		))
 	}
-	roomInfo = append(roomInfo, border)
-	for _, s := range roomInfo {
-		c.cli.Println(s)
+	if len(info.Spectators) > 0 {
+		spectators := make([]string, len(info.Spectators))
+		for i, spectator := range info.Spectators {
+			spectators[i] = spectator.Nickname
+			if spectator.Nickname == nickname {
+				spectators[i] = "(You)" + spectator.Nickname
+			}
+		}
+		lines = append(lines, "Spectators: "+strings.Join(spectators, ", "))
+	}
+	return lines
+}
+
+func formatVotes(info *mafia_connection.RoomInfo) []string {
+	if len(info.Votes) == 0 {
+		return nil
+	}
+	lines := []string{"Votes:"}
+	if info.State == mafia_connection.State_NIGHT {
+		lines = []string{"Mafia picks:"}
+	}
+	voters := make(map[uint64][]string)
+	order := make([]*mafia_connection.User, 0)
+	abstained := make([]string, 0)
+	for _, vote := range info.Votes {
+		if vote.Abstain {
+			abstained = append(abstained, vote.Voter.Nickname)
+			continue
+		}
+		if _, ok := voters[vote.Target.ID]; !ok {
+			order = append(order, vote.Target)
+		}
+		voters[vote.Target.ID] = append(voters[vote.Target.ID], vote.Voter.Nickname)
+	}
+	for _, target := range order {
+		lines = append(lines, fmt.Sprintf(
+			"  %s: %d (%s)",
+			target.Nickname,
+			len(voters[target.ID]),
+			strings.Join(voters[target.ID], ", "),
+		))
+	}
+	if len(abstained) > 0 {
+		lines = append(lines, "  Abstained: "+strings.Join(abstained, ", "))
+	}
+	return lines
+}
+
+func (c *Client) addAbstainOptions() {
+	c.possibleOptions[ABSTAIN_COMMAND] = Option{
+		control: true,
+		action: &mafia_connection.PlayerAction{
+			Action: &mafia_connection.PlayerAction_Abstain{Abstain: &empty.Empty{}},
+		},
+	}
+	c.possibleOptions[RETRACT_COMMAND] = Option{
+		control: true,
+		action: &mafia_connection.PlayerAction{
+			Action: &mafia_connection.PlayerAction_Retract{Retract: &empty.Empty{}},
+		},
+	}
+}
+
+func (c *Client) inRunoff(user *mafia_connection.User) bool {
+	if len(c.roomInfo.Runoff) == 0 {
+		return true
+	}
+	for _, candidate := range c.roomInfo.Runoff {
+		if candidate.ID == user.ID {
+			return true
+		}
 	}
+	return false
+}
+
+func (c *Client) isNominee(user *mafia_connection.User) bool {
+	if c.roomInfo.Rules != game.RulesSport.String() {
+		return true
+	}
+	for _, nominee := range c.roomInfo.Nominees {
+		if nominee.ID == user.ID {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Client) isFirstNight() bool {
+	return c.roomInfo.Rules == game.RulesSport.String() &&
+		c.roomInfo.State == mafia_connection.State_NIGHT &&
+		c.roomInfo.Day == 0
+}
+
+func (c *Client) addSpeechOptions() {
+	for _, p := range c.roomInfo.Players {
+		if p.Alive && !c.isNominee(p.User) {
+			c.possibleOptions[NOMINATE_COMMAND+p.User.Nickname] = Option{
+				action: &mafia_connection.PlayerAction{
+					Action: &mafia_connection.PlayerAction_Nominate{Nominate: p.User},
+				},
+			}
+		}
+	}
+	c.possibleOptions[PASS_COMMAND] = Option{
+		control: true,
+		action: &mafia_connection.PlayerAction{
+			Action: &mafia_connection.PlayerAction_EndSpeech{EndSpeech: &empty.Empty{}},
+		},
+	}
+}
+
+func (c *Client) addCheckOptions(self *mafia_connection.Player, role game.Role) {
+	for _, p := range c.roomInfo.Players {
+		if p.User.ID == self.User.ID || !role.CanTarget(self, p) {
+			continue
+		}
+		c.possibleOptions[game.NightActionCheck.String()+" "+p.User.Nickname] = Option{
+			action: &mafia_connection.PlayerAction{
+				Action: &mafia_connection.PlayerAction_Check{Check: p.User},
+			},
+		}
+	}
+}
+
+func (c *Client) getTimeLeft() string {
+	if c.roomInfo == nil || c.roomInfo.Deadline == nil {
+		return ""
+	}
+	left := time.Until(c.roomInfo.Deadline.AsTime()).Round(time.Second)
+	if left < 0 {
+		left = 0
+	}
+	return fmt.Sprintf("Time left: %s", left)
 }
 
 func (c *Client) addRandOption() {
+	if c.roomInfo.State != mafia_connection.State_NIGHT && c.roomInfo.State != mafia_connection.State_DAY {
+		return
+	}
 	for _, opt := range c.possibleOptions {
-		if !opt.chat {
+		if !opt.chat && !opt.control {
 			c.possibleOptions[RAND_COMMAND] = Option{rand: true}
 			return
 		}
@@ -126,43 +320,87 @@ func (c *Client) buildOptions() {
 	for k := range c.possibleOptions {
 		delete(c.possibleOptions, k)
 	}
+	if c.isGhost() && c.roomInfo.GetChat().GetGhosts() {
+		c.possibleOptions[CHAT_COMMAND] = Option{chat: true}
+	}
+	if c.isSpectator() {
+		return
+	}
 	self := c.roomInfo.Players[c.getMyId()]
 	if c.roomInfo.State == mafia_connection.State_END {
 		c.possibleOptions[CHAT_COMMAND] = Option{chat: true}
+		c.possibleOptions[REMATCH_COMMAND] = Option{
+			action: &mafia_connection.PlayerAction{
+				Action: &mafia_connection.PlayerAction_Rematch{Rematch: &empty.Empty{}},
+			},
+		}
+		return
+	}
+	if speaker := c.roomInfo.LastWordsSpeaker; speaker != nil {
+		if speaker.ID == self.User.ID {
+			c.possibleOptions[LAST_WORDS_COMMAND] = Option{chat: true}
+			c.possibleOptions[PASS_COMMAND] = Option{
+				control: true,
+				action: &mafia_connection.PlayerAction{
+					Action: &mafia_connection.PlayerAction_LastWords{LastWords: ""},
+				},
+			}
+		}
 		return
 	}
 	if !self.Alive {
 		return
 	}
 	if c.roomInfo.State == mafia_connection.State_NIGHT {
-		if self.Role != mafia_connection.Role_CIVILIAN {
+		if self.Role != mafia_connection.Role_CIVILIAN && c.roomInfo.GetChat().GetNight() {
 			c.possibleOptions[CHAT_COMMAND] = Option{chat: true}
-			voteOpt := ""
-			if self.Role == mafia_connection.Role_MAFIA {
-				voteOpt = "kill "
-			} else {
-				voteOpt = "check "
+		}
+		role := game.GetRole(self.Role)
+		if _, seeker := role.(game.Seeker); seeker {
+			c.addCheckOptions(self, role)
+		}
+		nightAction := role.NightAction()
+		if nightAction == game.NightActionNone {
+			return
+		}
+		if nightAction == game.NightActionKill && c.isFirstNight() {
+			return
+		}
+		c.addAbstainOptions()
+		for _, p := range c.roomInfo.Players {
+			if !role.CanTarget(self, p) {
+				continue
 			}
-			for _, p := range c.roomInfo.Players {
-				if p.Alive {
-					c.possibleOptions[voteOpt+p.User.Nickname] = Option{
-						chat: false,
-						action: &mafia_connection.PlayerAction{
-							Action: &mafia_connection.PlayerAction_Vote{
-								Vote: p.User,
-							},
-						},
-					}
+			action := &mafia_connection.PlayerAction{
+				Action: &mafia_connection.PlayerAction_Vote{
+					Vote: p.User,
+				},
+			}
+			if nightAction == game.NightActionHeal {
+				action = &mafia_connection.PlayerAction{
+					Action: &mafia_connection.PlayerAction_Heal{
+						Heal: p.User,
+					},
 				}
 			}
+			c.possibleOptions[nightAction.String()+" "+p.User.Nickname] = Option{
+				chat:   false,
+				action: action,
+			}
 		}
 		return
 	}
-	c.possibleOptions[CHAT_COMMAND] = Option{chat: true}
+	if c.roomInfo.State == mafia_connection.State_NOT_STARTED {
+		c.buildLobbyOptions(self)
+	}
+	if c.roomInfo.State == mafia_connection.State_NOT_STARTED || c.roomInfo.GetChat().GetDay() {
+		c.possibleOptions[CHAT_COMMAND] = Option{chat: true}
+	}
 	if c.roomInfo.State == mafia_connection.State_DAY {
-		if self.Role == mafia_connection.Role_SHERIFF {
+		role := game.GetRole(self.Role)
+		if _, seeker := role.(game.Seeker); role.NightAction() == game.NightActionCheck && !seeker {
 			for _, p := range c.roomInfo.Players {
-				if p.Alive && p.Role == mafia_connection.Role_MAFIA {
+				if p.Alive && game.GetRole(p.Role).Faction() == game.FactionMafia {
 					c.possibleOptions["show "+p.User.Nickname] = Option{
 						chat: false,
 						action: &mafia_connection.PlayerAction{
@@ -174,8 +412,15 @@ func (c *Client) buildOptions() {
 				}
 			}
 		}
+		if speaker := c.roomInfo.Speaker; speaker != nil {
+			if speaker.ID == self.User.ID {
+				c.addSpeechOptions()
+			}
+			return
+		}
+		c.addAbstainOptions()
 		for _, p := range c.roomInfo.Players {
-			if p.Alive {
+			if p.Alive && c.inRunoff(p.User) && c.isNominee(p.User) {
 				c.possibleOptions["vote "+p.User.Nickname] = Option{
 					chat: false,
 					action: &mafia_connection.PlayerAction{
@@ -193,6 +438,11 @@ func (c *Client) buildOptions() {
 func (c *Client) buildOptionsAndSuggests() {
 	c.buildOptions()
 	c.cli.Suggests = make([]prompt.Suggest, 0)
+	if c.roomInfo.Deadline != nil {
+		c.cli.Suggests = append(c.cli.Suggests, prompt.Suggest{
+			Text: TIME_COMMAND,
+		})
+	}
 	for text, opt := range c.possibleOptions {
 		if opt.rand {
 			c.cli.Suggests = append(c.cli.Suggests, prompt.Suggest{
@@ -215,6 +465,11 @@ func (c *Client) ResolveAction(action *mafia_connection.ServerAction) error {
 	switch {
 	case action.GetServerMessage() != "":
 		c.cli.Println(action.GetServerMessage())
+	case action.GetSession() != "":
+		c.session = action.GetSession()
+	case action.GetLastWords() != nil:
+		lastWords := action.GetLastWords()
+		c.cli.Println(fmt.Sprintf("[Last words] <%s> %s", lastWords.Author.Nickname, lastWords.Text))
 	case action.GetEvent() != nil:
 		event := action.GetEvent()
 		addChats := false
@@ -223,7 +478,16 @@ func (c *Client) ResolveAction(action *mafia_connection.ServerAction) error {
 			event.RoomInfo.State != mafia_connection.State_NOT_STARTED {
 			addChats = true
 		}
+		if c.roomInfo != nil &&
+			c.roomInfo.State == mafia_connection.State_END &&
+			event.RoomInfo.State == mafia_connection.State_NOT_STARTED {
+			c.removeRoleChat()
+			c.removeGhostChat()
+		}
 		c.roomInfo = event.RoomInfo
+		if c.isGhost() {
+			c.addGhostChat()
+		}
 		if addChats {
 			c.addRoleChat()
 		}
@@ -239,6 +503,26 @@ func (c *Client) ResolveAction(action *mafia_connection.ServerAction) error {
 func (c *Client) ResolveCommand(command string) error {
 	c.mux.Lock()
 	defer c.mux.Unlock()
+	if command == TIME_COMMAND {
+		timeLeft := c.getTimeLeft()
+		if timeLeft == "" {
+			timeLeft = "No deadline"
+		}
+		c.cli.Println(timeLeft)
+		return nil
+	}
+	if strings.HasPrefix(command, LAST_WORDS_COMMAND) {
+		_, ok := c.possibleOptions[LAST_WORDS_COMMAND]
+		if !ok {
+			c.cli.Println(UNKNOWN_COMMAND)
+			return errUnknownCommand
+		}
+		return c.stream.Send(&mafia_connection.PlayerAction{
+			Action: &mafia_connection.PlayerAction_LastWords{
+				LastWords: command[len(LAST_WORDS_COMMAND):],
+			},
+		})
+	}
 	if strings.HasPrefix(command, CHAT_COMMAND) {
 		_, ok := c.possibleOptions[CHAT_COMMAND]
 		if !ok {
@@ -253,18 +537,18 @@ func (c *Client) ResolveCommand(command string) error {
 		return errUnknownCommand
 	}
 	if opt.rand {
-		cnt := rand.Intn(len(c.possibleOptions) - 2)
+		candidates := make([]string, 0, len(c.possibleOptions))
 		for desc, opt := range c.possibleOptions {
-			if !opt.chat && !opt.rand {
-				if cnt > 0 {
-					cnt--
-				} else {
-					c.cli.Println(desc)
-					return c.stream.Send(opt.action)
-				}
+			if !opt.chat && !opt.rand && !opt.control {
+				candidates = append(candidates, desc)
 			}
 		}
-		return nil
+		if len(candidates) == 0 {
+			return nil
+		}
+		desc := candidates[rand.Intn(len(candidates))]
+		c.cli.Println(desc)
+		return c.stream.Send(c.possibleOptions[desc].action)
 	} else {
 		return c.stream.Send(opt.action)
 	}
@@ -280,12 +564,22 @@ func (c *Client) HandleServerActions(
 		case <-stopJobs:
 			return nil
 		default:
-			serverAction, err := c.stream.Recv()
+			c.mux.Lock()
+			stream := c.stream
+			c.mux.Unlock()
+			serverAction, err := stream.Recv()
 			if err == io.EOF {
 				return nil
 			}
 			if err != nil {
-				return err
+				if c.ctx.Err() != nil {
+					return nil
+				}
+				if err := c.reconnect(); err != nil {
+					c.cli.Println(err.Error())
+					return err
+				}
+				continue
 			}
 			c.ResolveAction(serverAction)
 		}
@@ -328,29 +622,107 @@ func (c *Client) HandleChat(
 	}
 }
 
+func (c *Client) HandleCountdown(
+	stopJobs chan bool,
+	jobs *sync.WaitGroup,
+) {
+	defer jobs.Done()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopJobs:
+			return
+		case <-ticker.C:
+			c.mux.Lock()
+			if c.roomInfo != nil && c.roomInfo.Deadline != nil {
+				left := time.Until(c.roomInfo.Deadline.AsTime()).Round(time.Second)
+				for _, warning := range COUNTDOWN_WARNINGS {
+					if left == warning {
+						c.cli.Println(fmt.Sprintf("%s left until the end of the %s", left, strings.ToLower(c.roomInfo.State.String())))
+					}
+				}
+			}
+			c.mux.Unlock()
+		}
+	}
+}
+
 func (c *Client) BeforeConnection() error {
 	for {
-		choice, err := cli.Choice("Select option (Ctrl + C to exit)", []string{CHANGE_NICKNAME_OPTION, CONNECT_TO_SERVER_OPTION})
+		choice, err := cli.Choice("Select option (Ctrl + C to exit)", []string{
+			CHANGE_NICKNAME_OPTION,
+			CONNECT_TO_SERVER_OPTION,
+			LIST_ROOMS_OPTION,
+			CREATE_ROOM_OPTION,
+			JOIN_ROOM_OPTION,
+			JOIN_PRIVATE_ROOM_OPTION,
+			SPECTATE_ROOM_OPTION,
+			REPLAY_GAME_OPTION,
+		})
 		if err != nil {
 			return err
 		}
-		if choice == CONNECT_TO_SERVER_OPTION {
+		switch choice {
+		case CONNECT_TO_SERVER_OPTION:
 			return nil
-		}
-		c.nickname, err = cli.Input("Enter new nickname", c.nickname, utils.ValidateNickname, utils.GetErrorMessageForNickname)
-		if err != nil {
-			return err
+		case LIST_ROOMS_OPTION:
+			if err := c.printRooms(); err != nil {
+				printRPCError(err)
+			}
+		case CREATE_ROOM_OPTION:
+			if err := c.createRoom(); err != nil {
+				printRPCError(err)
+			} else {
+				return nil
+			}
+		case JOIN_ROOM_OPTION:
+			joined, err := c.joinRoom()
+			if err != nil {
+				printRPCError(err)
+			} else if joined {
+				return nil
+			}
+		case SPECTATE_ROOM_OPTION:
+			joined, err := c.spectateRoom()
+			if err != nil {
+				printRPCError(err)
+			} else if joined {
+				return nil
+			}
+		case REPLAY_GAME_OPTION:
+			if err := c.replayGame(); err != nil {
+				printRPCError(err)
+			}
+		case JOIN_PRIVATE_ROOM_OPTION:
+			if err := c.joinPrivateRoom(); err != nil {
+				printRPCError(err)
+			} else {
+				return nil
+			}
+		default:
+			c.nickname, err = cli.Input("Enter new nickname", c.nickname, utils.ValidateNickname, utils.GetErrorMessageForNickname)
+			if err != nil {
+				return err
+			}
 		}
 	}
 }
 
-func (c *Client) Run(addr string, rabbitMqCreds string) {
-	err := c.BeforeConnection()
+func (c *Client) Run() {
+	conn, err := grpc.Dial(c.config.ServerAddr, grpc.WithTransportCredentials(insecure.NewCredentials())) // TODO: Add TLS
+	if err != nil {
+		log.Fatalf("Fail to connect server: %v", err)
+	}
+	defer conn.Close()
+	c.grpcClient = mafia_connection.NewMafiaServiceClient(conn)
+
+	err = c.BeforeConnection()
 	if err != nil {
 		log.Fatalf("Internal error: %v", err)
 	}
 
-	chatConn, err := amqp.Dial(rabbitMqCreds)
+	chatConn, err := amqp.Dial(c.config.RabbitmqCreds)
 	if err != nil {
 		log.Fatalf("Fail to connect to rabbitmq: %v", err)
 	}
@@ -362,15 +734,9 @@ func (c *Client) Run(addr string, rabbitMqCreds string) {
 	}
 	defer c.chat.ch.Close()
 
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials())) // TODO: Add TLS
-	if err != nil {
-		log.Fatalf("Fail to connect server: %v", err)
-	}
-	defer conn.Close()
-
 	ctx, cancel := context.WithCancel(context.Background())
+	c.ctx = ctx
 
-	c.grpcClient = mafia_connection.NewMafiaServiceClient(conn)
 	c.stream, err = c.grpcClient.RouteGame(ctx)
 	if err != nil {
 		log.Fatalf("RouteGame failed: %v", err)
@@ -378,10 +744,7 @@ func (c *Client) Run(addr string, rabbitMqCreds string) {
 
 	init := &mafia_connection.PlayerAction{
 		Action: &mafia_connection.PlayerAction_Connetion{
-			Connetion: &mafia_connection.User{
-				ID:       utils.NicknameHash(c.nickname),
-				Nickname: c.nickname,
-			},
+			Connetion: c.getUser(),
 		},
 	}
 	if err := c.stream.Send(init); err != nil {
@@ -394,6 +757,10 @@ func (c *Client) Run(addr string, rabbitMqCreds string) {
 		return
 	}
 	c.ResolveAction(serverAction)
+	if c.roomInfo == nil {
+		cancel()
+		return
+	}
 
 	err = c.initChat()
 	if err != nil {
@@ -404,13 +771,17 @@ func (c *Client) Run(addr string, rabbitMqCreds string) {
 
 	stopJobs := make(chan bool)
 	var jobs sync.WaitGroup
-	jobs.Add(3)
+	jobs.Add(4)
 	defer jobs.Wait()
 
 	go c.HandleCLIActions(stopJobs, &jobs)
 	go c.HandleChat(stopJobs, &jobs)
+	go c.HandleCountdown(stopJobs, &jobs)
 	go c.HandleServerActions(stopJobs, &jobs)
 	c.prompt.Run()
+	c.mux.Lock()
+	c.stream.CloseSend()
+	c.mux.Unlock()
 	cancel()
 	close(stopJobs)
 }
@@ -418,8 +789,33 @@ func (c *Client) Run(addr string, rabbitMqCreds string) {
 var (
 	CONNECT_TO_SERVER_OPTION = "Connect to server"
 	CHANGE_NICKNAME_OPTION   = "Change nickname"
+	LIST_ROOMS_OPTION        = "List rooms"
+	CREATE_ROOM_OPTION       = "Create room"
+	JOIN_ROOM_OPTION         = "Join room"
+	JOIN_PRIVATE_ROOM_OPTION = "Join private room"
+	SPECTATE_ROOM_OPTION     = "Spectate room"
+	REPLAY_GAME_OPTION       = "Replay game"
+	NEXT_EVENT_OPTION        = "Next event"
+	PREVIOUS_EVENT_OPTION    = "Previous event"
+	PUBLIC_ROOM_OPTION       = "Public"
+	PRIVATE_ROOM_OPTION      = "Private"
+	CUSTOM_RULES_OPTION      = "Custom"
+	BACK_OPTION              = "Back"
 	CHAT_COMMAND             = "chat "
 	RAND_COMMAND             = "rand"
+	READY_COMMAND            = "ready"
+	UNREADY_COMMAND          = "unready"
+	START_COMMAND            = "start"
+	KICK_COMMAND             = "kick "
+	REMATCH_COMMAND          = "rematch"
+	ABSTAIN_COMMAND          = "abstain"
+	RETRACT_COMMAND          = "retract"
+	LAST_WORDS_COMMAND       = "say "
+	PASS_COMMAND             = "pass"
+	NOMINATE_COMMAND         = "nominate "
+	GHOST_CHAT               = "GHOST"
+	TIME_COMMAND             = "time"
 	UNKNOWN_COMMAND          = "Unknown command"
 	errUnknownCommand        = errors.New(UNKNOWN_COMMAND)
+	COUNTDOWN_WARNINGS       = []time.Duration{30 * time.Second, 10 * time.Second}
 )
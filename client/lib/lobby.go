@@ -0,0 +1,248 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"mafia/client/lib/cli"
+	"mafia/game"
+	mafia_connection "mafia/protos"
+	"mafia/utils"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/status"
+)
+
+func (c *Client) getUser() *mafia_connection.User {
+	return &mafia_connection.User{
+		ID:       utils.NicknameHash(c.nickname),
+		Nickname: c.nickname,
+	}
+}
+
+func formatRoom(room *mafia_connection.RoomDescription) string {
+	return fmt.Sprintf(
+		"'%s' (ID: %d, Players: %d/%d, State: %s, Rules: %s, Tie-break: %s, Reveal on death: %s)",
+		room.Name,
+		room.RoomID,
+		room.PlayersCount,
+		room.MaxPlayers,
+		room.State.String(),
+		rulesName(room),
+		room.TieBreak,
+		room.RevealOnDeath,
+	)
+}
+
+func rulesName(room *mafia_connection.RoomDescription) string {
+	if room.Ruleset != "" {
+		return fmt.Sprintf("%s (%s)", room.Ruleset, room.Rules)
+	}
+	return room.Rules
+}
+
+func (c *Client) listRulesets() ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	list, err := c.grpcClient.ListRulesets(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return list.Names, nil
+}
+
+func (c *Client) listRooms() ([]*mafia_connection.RoomDescription, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	list, err := c.grpcClient.ListRooms(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return list.Rooms, nil
+}
+
+func (c *Client) printRooms() error {
+	rooms, err := c.listRooms()
+	if err != nil {
+		return err
+	}
+	if len(rooms) == 0 {
+		fmt.Println("No open rooms")
+		return nil
+	}
+	for _, room := range rooms {
+		fmt.Println(formatRoom(room))
+	}
+	return nil
+}
+
+func (c *Client) requestJoin(roomID uint64, inviteCode string, spectate bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	room, err := c.grpcClient.JoinRoom(ctx, &mafia_connection.JoinRoomRequest{
+		User:       c.getUser(),
+		RoomID:     roomID,
+		InviteCode: inviteCode,
+		Spectate:   spectate,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Joining %s\n", formatRoom(room))
+	return nil
+}
+
+func (c *Client) createRoom() error {
+	name, err := cli.Input("Enter room name", c.nickname+"'s room", utils.ValidateRoomName, utils.GetErrorMessageForRoomName)
+	if err != nil {
+		return err
+	}
+	visibility, err := cli.Choice("Select room visibility", []string{PUBLIC_ROOM_OPTION, PRIVATE_ROOM_OPTION})
+	if err != nil {
+		return err
+	}
+	request := &mafia_connection.CreateRoomRequest{
+		Name:    name,
+		Private: visibility == PRIVATE_ROOM_OPTION,
+	}
+	rulesets, err := c.listRulesets()
+	if err != nil {
+		return err
+	}
+	if len(rulesets) > 0 {
+		ruleset, err := cli.Choice("Select ruleset", append([]string{CUSTOM_RULES_OPTION}, rulesets...))
+		if err != nil {
+			return err
+		}
+		if ruleset != CUSTOM_RULES_OPTION {
+			request.Ruleset = ruleset
+		}
+	}
+	if request.Ruleset == "" {
+		request.Rules, err = cli.Choice("Select rules", game.RulesNames())
+		if err != nil {
+			return err
+		}
+		request.TieBreak, err = cli.Choice("Select tie-break policy", game.TieBreakNames())
+		if err != nil {
+			return err
+		}
+		request.RevealOnDeath, err = cli.Choice("Select role reveal on death", game.RevealPolicyNames())
+		if err != nil {
+			return err
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	room, err := c.grpcClient.CreateRoom(ctx, request)
+	if err != nil {
+		return err
+	}
+	if room.Private {
+		fmt.Printf("Invite code for the room: %s\n", room.InviteCode)
+	}
+	return c.requestJoin(room.RoomID, room.InviteCode, false)
+}
+
+func (c *Client) joinRoom() (bool, error) {
+	rooms, err := c.listRooms()
+	if err != nil {
+		return false, err
+	}
+	choices := []string{BACK_OPTION}
+	ids := make(map[string]uint64)
+	for _, room := range rooms {
+		if room.State != mafia_connection.State_NOT_STARTED || room.PlayersCount >= room.MaxPlayers {
+			continue
+		}
+		label := formatRoom(room)
+		choices = append(choices, label)
+		ids[label] = room.RoomID
+	}
+	choice, err := cli.Choice("Select room", choices)
+	if err != nil || choice == BACK_OPTION {
+		return false, err
+	}
+	return true, c.requestJoin(ids[choice], "", false)
+}
+
+func (c *Client) spectateRoom() (bool, error) {
+	rooms, err := c.listRooms()
+	if err != nil {
+		return false, err
+	}
+	choices := []string{BACK_OPTION}
+	ids := make(map[string]uint64)
+	for _, room := range rooms {
+		label := formatRoom(room)
+		choices = append(choices, label)
+		ids[label] = room.RoomID
+	}
+	choice, err := cli.Choice("Select room to spectate", choices)
+	if err != nil || choice == BACK_OPTION {
+		return false, err
+	}
+	return true, c.requestJoin(ids[choice], "", true)
+}
+
+func (c *Client) joinPrivateRoom() error {
+	code, err := cli.Input("Enter invite code", c.config.RoomCode, validateInviteCode, getErrorMessageForInviteCode)
+	if err != nil {
+		return err
+	}
+	return c.requestJoin(0, strings.ToUpper(strings.TrimSpace(code)), false)
+}
+
+func validateInviteCode(code string) bool {
+	return strings.TrimSpace(code) != ""
+}
+
+func getErrorMessageForInviteCode(code string) string {
+	if validateInviteCode(code) {
+		return ""
+	}
+	return "Invite code must not be empty"
+}
+
+func (c *Client) isHost() bool {
+	return c.roomInfo.Host != nil && c.roomInfo.Host.Nickname == c.nickname
+}
+
+func (c *Client) buildLobbyOptions(self *mafia_connection.Player) {
+	if self.Ready {
+		c.possibleOptions[UNREADY_COMMAND] = Option{
+			action: &mafia_connection.PlayerAction{
+				Action: &mafia_connection.PlayerAction_Ready{Ready: false},
+			},
+		}
+	} else {
+		c.possibleOptions[READY_COMMAND] = Option{
+			action: &mafia_connection.PlayerAction{
+				Action: &mafia_connection.PlayerAction_Ready{Ready: true},
+			},
+		}
+	}
+	if !c.isHost() {
+		return
+	}
+	c.possibleOptions[START_COMMAND] = Option{
+		action: &mafia_connection.PlayerAction{
+			Action: &mafia_connection.PlayerAction_Start{Start: &empty.Empty{}},
+		},
+	}
+	for _, p := range c.roomInfo.Players {
+		if p.User.Nickname == c.nickname {
+			continue
+		}
+		c.possibleOptions[KICK_COMMAND+p.User.Nickname] = Option{
+			action: &mafia_connection.PlayerAction{
+				Action: &mafia_connection.PlayerAction_Kick{Kick: p.User},
+			},
+		}
+	}
+}
+
+func printRPCError(err error) {
+	fmt.Println(status.Convert(err).Message())
+}
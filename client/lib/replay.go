@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mafia/client/lib/cli"
+	"mafia/game"
+	mafia_connection "mafia/protos"
+	"strconv"
+	"strings"
+)
+
+func (c *Client) fetchReplay(gameID uint64) ([]*mafia_connection.GameEvent, error) {
+	stream, err := c.grpcClient.ReplayGame(context.Background(), &mafia_connection.ReplayRequest{GameID: gameID})
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*mafia_connection.GameEvent, 0)
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return events, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+}
+
+func printReplayStep(gameID uint64, events []*mafia_connection.GameEvent, step int) {
+	roomInfo := game.ReplayRoomInfo(gameID, events[:step+1])
+	border := strings.Repeat("-", 75)
+	lines := []string{border}
+	lines = append(lines, fmt.Sprintf(
+		"Game: '%d', Event: %d/%d, State: %s",
+		gameID,
+		step+1,
+		len(events),
+		roomInfo.State.String(),
+	))
+	lines = append(lines, formatPlayers(roomInfo, -1, "")...)
+	lines = append(lines, border)
+	lines = append(lines, fmt.Sprintf(
+		"[%s] %s",
+		events[step].Time.AsTime().Format("15:04:05"),
+		game.DescribeEvent(events[step]),
+	))
+	for _, line := range lines {
+		fmt.Println(line)
+	}
+}
+
+func (c *Client) replayGame() error {
+	input, err := cli.Input("Enter game ID", "", validateGameID, getErrorMessageForGameID)
+	if err != nil {
+		return err
+	}
+	gameID, _ := strconv.ParseUint(strings.TrimSpace(input), 10, 64)
+	events, err := c.fetchReplay(gameID)
+	if err != nil {
+		return err
+	}
+	if len(events) == 0 {
+		fmt.Println("Game has no events")
+		return nil
+	}
+	step := 0
+	for {
+		printReplayStep(gameID, events, step)
+		choices := make([]string, 0, 3)
+		if step+1 < len(events) {
+			choices = append(choices, NEXT_EVENT_OPTION)
+		}
+		if step > 0 {
+			choices = append(choices, PREVIOUS_EVENT_OPTION)
+		}
+		choices = append(choices, BACK_OPTION)
+		choice, err := cli.Choice("Select option", choices)
+		if err != nil {
+			return err
+		}
+		switch choice {
+		case NEXT_EVENT_OPTION:
+			step++
+		case PREVIOUS_EVENT_OPTION:
+			step--
+		default:
+			return nil
+		}
+	}
+}
+
+func validateGameID(input string) bool {
+	_, err := strconv.ParseUint(strings.TrimSpace(input), 10, 64)
+	return err == nil
+}
+
+func getErrorMessageForGameID(input string) string {
+	if validateGameID(input) {
+		return ""
+	}
+	return "Game ID must be a number"
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"errors"
+	mafia_connection "mafia/protos"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *Client) reconnect() error {
+	if c.session == "" {
+		return errReconnectFailed
+	}
+	c.cli.Println("Connection lost, trying to reconnect...")
+	for attempt := 0; attempt < RECONNECT_ATTEMPTS; attempt++ {
+		select {
+		case <-c.ctx.Done():
+			return c.ctx.Err()
+		case <-time.After(RECONNECT_DELAY):
+		}
+		stream, err := c.grpcClient.RouteGame(c.ctx)
+		if err != nil {
+			continue
+		}
+		err = stream.Send(&mafia_connection.PlayerAction{
+			Action: &mafia_connection.PlayerAction_Reconnect{
+				Reconnect: &mafia_connection.ReconnectRequest{
+					User:    c.getUser(),
+					Session: c.session,
+				},
+			},
+		})
+		if err != nil {
+			continue
+		}
+		accepted, err := c.awaitReconnect(stream)
+		if err != nil {
+			return err
+		}
+		if !accepted {
+			continue
+		}
+		c.mux.Lock()
+		c.stream = stream
+		c.mux.Unlock()
+		return nil
+	}
+	return errReconnectFailed
+}
+
+// awaitReconnect waits for the room event the server sends once the player is
+// back in the room. A rejected session won't be accepted on a retry either.
+func (c *Client) awaitReconnect(stream mafia_connection.MafiaService_RouteGameClient) (bool, error) {
+	for {
+		action, err := stream.Recv()
+		if status.Code(err) == codes.Unauthenticated {
+			c.mux.Lock()
+			c.session = ""
+			c.mux.Unlock()
+			return false, errReconnectFailed
+		}
+		if err != nil {
+			return false, nil
+		}
+		c.ResolveAction(action)
+		if action.GetEvent() != nil {
+			return true, nil
+		}
+	}
+}
+
+const (
+	RECONNECT_ATTEMPTS = 10
+	RECONNECT_DELAY    = 3 * time.Second
+)
+
+var (
+	errReconnectFailed = errors.New("failed to reconnect, press Ctrl + D to leave")
+)
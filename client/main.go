@@ -27,9 +27,9 @@ func main() {
 		return
 	}
 
-	client := client.GetClient()
+	client := client.GetClient(cfg)
 	for {
-		client.Run(cfg.ServerAddr, cfg.RabbitmqCreds)
+		client.Run()
 		client.Clear()
 	}
 }
@@ -0,0 +1,265 @@
+package game
+
+import (
+	"fmt"
+	mafia_connection "mafia/protos"
+	"mafia/utils"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+type BotStrategy interface {
+	NightTarget(view *mafia_connection.RoomInfo, self int, action NightAction) int
+	DayVote(view *mafia_connection.RoomInfo, self int) int
+	Show(view *mafia_connection.RoomInfo, self int) int
+}
+
+var botStrategies = make(map[string]func(rng *rand.Rand) BotStrategy)
+
+func RegisterBotStrategy(name string, factory func(rng *rand.Rand) BotStrategy) {
+	botStrategies[name] = factory
+}
+
+func IsRegisteredBotStrategy(name string) bool {
+	_, ok := botStrategies[name]
+	return ok
+}
+
+func BotStrategyNames() []string {
+	names := make([]string, 0, len(botStrategies))
+	for name := range botStrategies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func isEnemy(self *mafia_connection.Player, other *mafia_connection.Player) bool {
+	if other.Role == mafia_connection.Role_UNKNOWN {
+		return false
+	}
+	return GetRole(other.Role).Faction() != GetRole(self.Role).Faction()
+}
+
+func isAlly(self *mafia_connection.Player, other *mafia_connection.Player) bool {
+	if other.Role == mafia_connection.Role_UNKNOWN {
+		return false
+	}
+	return GetRole(other.Role).Faction() == GetRole(self.Role).Faction()
+}
+
+func pickRandom(rng *rand.Rand, candidates []int) int {
+	if len(candidates) == 0 {
+		return -1
+	}
+	return candidates[rng.Intn(len(candidates))]
+}
+
+func filterPlayers(view *mafia_connection.RoomInfo, filter func(i int, p *mafia_connection.Player) bool) []int {
+	res := make([]int, 0)
+	for i, p := range view.Players {
+		if p.Alive && filter(i, p) {
+			res = append(res, i)
+		}
+	}
+	return res
+}
+
+type randomStrategy struct {
+	rng *rand.Rand
+}
+
+func (s randomStrategy) NightTarget(view *mafia_connection.RoomInfo, self int, action NightAction) int {
+	role := GetRole(view.Players[self].Role)
+	return pickRandom(s.rng, filterPlayers(view, func(i int, p *mafia_connection.Player) bool {
+		return role.CanTarget(view.Players[self], p)
+	}))
+}
+
+func (s randomStrategy) DayVote(view *mafia_connection.RoomInfo, self int) int {
+	return pickRandom(s.rng, filterPlayers(view, func(i int, p *mafia_connection.Player) bool {
+		return i != self
+	}))
+}
+
+func (randomStrategy) Show(view *mafia_connection.RoomInfo, self int) int {
+	return -1
+}
+
+type heuristicStrategy struct {
+	rng *rand.Rand
+}
+
+func (s heuristicStrategy) NightTarget(view *mafia_connection.RoomInfo, self int, action NightAction) int {
+	me := view.Players[self]
+	role := GetRole(me.Role)
+	allowed := func(i int, p *mafia_connection.Player) bool {
+		return role.CanTarget(me, p)
+	}
+	switch action {
+	case NightActionKill:
+		if target := pickRandom(s.rng, filterPlayers(view, func(i int, p *mafia_connection.Player) bool {
+			return allowed(i, p) && p.Role == mafia_connection.Role_SHERIFF && isEnemy(me, p)
+		})); target != -1 {
+			return target
+		}
+		return pickRandom(s.rng, filterPlayers(view, func(i int, p *mafia_connection.Player) bool {
+			return allowed(i, p) && i != self && !isAlly(me, p)
+		}))
+	case NightActionCheck:
+		return pickRandom(s.rng, filterPlayers(view, func(i int, p *mafia_connection.Player) bool {
+			return allowed(i, p) && i != self && p.Role == mafia_connection.Role_UNKNOWN
+		}))
+	default:
+		return pickRandom(s.rng, filterPlayers(view, allowed))
+	}
+}
+
+func (s heuristicStrategy) DayVote(view *mafia_connection.RoomInfo, self int) int {
+	me := view.Players[self]
+	if target := pickRandom(s.rng, filterPlayers(view, func(i int, p *mafia_connection.Player) bool {
+		return i != self && isEnemy(me, p)
+	})); target != -1 {
+		return target
+	}
+	return pickRandom(s.rng, filterPlayers(view, func(i int, p *mafia_connection.Player) bool {
+		return i != self && !isAlly(me, p)
+	}))
+}
+
+func (s heuristicStrategy) Show(view *mafia_connection.RoomInfo, self int) int {
+	me := view.Players[self]
+	if GetRole(me.Role).NightAction() != NightActionCheck {
+		return -1
+	}
+	return pickRandom(s.rng, filterPlayers(view, func(i int, p *mafia_connection.Player) bool {
+		return i != self && isEnemy(me, p)
+	}))
+}
+
+func init() {
+	RegisterBotStrategy("random", func(rng *rand.Rand) BotStrategy { return randomStrategy{rng: rng} })
+	RegisterBotStrategy("heuristic", func(rng *rand.Rand) BotStrategy { return heuristicStrategy{rng: rng} })
+}
+
+func (r *Room) addBot() {
+	nickname := "Bot" + utils.GenerateNicknameWith(r.rng)
+	if len(nickname) > 15 {
+		nickname = nickname[:15]
+	}
+	r.players = append(r.players, &Player{
+		voteFor:   -1,
+		checkedBy: make(map[mafia_connection.Role]bool),
+		bot:       botStrategies[r.config.BotStrategy](r.rng),
+		info: mafia_connection.Player{
+			User: &mafia_connection.User{
+				ID:       utils.NicknameHash(nickname),
+				Nickname: nickname,
+			},
+			Role:  mafia_connection.Role_UNKNOWN,
+			Alive: true,
+			Bot:   true,
+		},
+		ready: true,
+	})
+	r.record(&mafia_connection.GameEvent{
+		Type:  mafia_connection.EventType_JOIN,
+		Actor: r.players[len(r.players)-1].info.User,
+		Bot:   true,
+	})
+	r.sendForAll(fmt.Sprintf("Bot '%s' joined room '%d'", nickname, r.ID))
+}
+
+func (r *Room) startBotFillTimer() {
+	if r.config.BotWait <= 0 || r.botFillTimer != nil {
+		return
+	}
+	r.botFillTimer = time.AfterFunc(r.config.BotWait, func() {
+		r.mux.Lock()
+		defer r.mux.Unlock()
+		r.botFillTimer = nil
+		if r.state != mafia_connection.State_NOT_STARTED || len(r.players) == 0 {
+			return
+		}
+		for len(r.players) < int(r.config.MaxPlayers) {
+			r.addBot()
+		}
+	})
+}
+
+func (r *Room) scheduleBots() {
+	phase := r.phase
+	time.AfterFunc(BOT_ACTION_DELAY, func() {
+		r.mux.Lock()
+		defer r.mux.Unlock()
+		if r.phase != phase {
+			return
+		}
+		r.actBots()
+	})
+}
+
+func (r *Room) actBots() {
+	phase := r.phase
+	for i, p := range r.players {
+		if p.bot == nil || !p.info.Alive {
+			continue
+		}
+		view := r.getRoomInfoForPlayer(p.info.User.ID)
+		if r.state == mafia_connection.State_DAY {
+			if target := p.bot.Show(view, i); target != -1 && r.players[target].checkedBy[p.info.Role] && !r.players[target].shownBySherif {
+				r.showRequest(p.info.User, r.players[target].info.User)
+			}
+		}
+		if r.waitsForCheck(p) && p.checkTarget == nil {
+			if target := p.bot.NightTarget(view, i, NightActionCheck); target != -1 && target != i {
+				r.checkRequest(p.info.User, r.players[target].info.User)
+				if r.phase != phase {
+					return
+				}
+			}
+		}
+		if !r.waitsForVote(p) {
+			continue
+		}
+		if r.inConsensus(p) {
+			if target := r.teamPick(p); target >= 0 {
+				if target != p.voteFor {
+					r.voteRequest(p.info.User, r.players[target].info.User)
+					if r.phase != phase {
+						return
+					}
+				}
+				continue
+			}
+		}
+		if p.voteFor != -1 {
+			continue
+		}
+		var target int
+		if r.state == mafia_connection.State_NIGHT {
+			target = p.bot.NightTarget(view, i, GetRole(p.info.Role).NightAction())
+		} else {
+			target = p.bot.DayVote(view, i)
+			if target != -1 && !r.isCandidate(target) {
+				target = pickRandom(r.rng, r.getCandidates())
+			}
+		}
+		if target == -1 {
+			continue
+		}
+		if GetRole(p.info.Role).NightAction() == NightActionHeal && r.state == mafia_connection.State_NIGHT {
+			r.healRequest(p.info.User, r.players[target].info.User)
+		} else {
+			r.voteRequest(p.info.User, r.players[target].info.User)
+		}
+		if r.phase != phase {
+			return
+		}
+	}
+}
+
+const (
+	BOT_ACTION_DELAY = 2 * time.Second
+)
@@ -0,0 +1,192 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	mafia_connection "mafia/protos"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type RoomConfig struct {
+	MinPlayers              uint32
+	MaxPlayers              uint32
+	Roles                   map[mafia_connection.Role]uint32
+	DayDuration             time.Duration
+	NightDuration           time.Duration
+	RevealRolesToSpectators bool
+	BotWait                 time.Duration
+	BotStrategy             string
+	TieBreak                TieBreak
+	LastWordsDuration       time.Duration
+	Rules                   Rules
+	SpeechDuration          time.Duration
+	Ruleset                 string
+	Phases                  []mafia_connection.State
+	RevealOnDeath           RevealPolicy
+	Chat                    ChatPermissions
+	MafiaVote               MafiaVote
+	ConsensusFallback       ConsensusFallback
+	RematchWait             time.Duration
+
+	casualSeating *seating
+}
+
+type ChatPermissions struct {
+	Day    bool
+	Night  bool
+	Ghosts bool
+}
+
+func AllChats() ChatPermissions {
+	return ChatPermissions{Day: true, Night: true, Ghosts: true}
+}
+
+func ParseRoles(spec string) (map[mafia_connection.Role]uint32, error) {
+	roles := make(map[mafia_connection.Role]uint32)
+	if strings.TrimSpace(spec) == "" {
+		return roles, nil
+	}
+	for _, item := range strings.Split(spec, ",") {
+		name, cnt, ok := strings.Cut(strings.TrimSpace(item), ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid role count '%s', expected ROLE:COUNT", item)
+		}
+		role, err := ParseRole(name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := strconv.ParseUint(cnt, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid count for role '%s': %w", name, err)
+		}
+		roles[role] += uint32(value)
+	}
+	return roles, nil
+}
+
+func ParseRole(name string) (mafia_connection.Role, error) {
+	role, ok := mafia_connection.Role_value[strings.ToUpper(strings.TrimSpace(name))]
+	if !ok || mafia_connection.Role(role) == mafia_connection.Role_UNKNOWN {
+		return mafia_connection.Role_UNKNOWN, fmt.Errorf("unknown role '%s'", name)
+	}
+	return mafia_connection.Role(role), nil
+}
+
+func ParsePhase(name string) (mafia_connection.State, error) {
+	state, ok := mafia_connection.State_value[strings.ToUpper(strings.TrimSpace(name))]
+	if !ok || (mafia_connection.State(state) != mafia_connection.State_DAY && mafia_connection.State(state) != mafia_connection.State_NIGHT) {
+		return mafia_connection.State_NOT_STARTED, fmt.Errorf("unknown phase '%s', expected day or night", name)
+	}
+	return mafia_connection.State(state), nil
+}
+
+func (c *RoomConfig) Validate() error {
+	if c.MinPlayers < 3 {
+		return errTooFewPlayers
+	}
+	if c.MinPlayers > c.MaxPlayers {
+		return errMinAboveMax
+	}
+	special, mafia := uint32(0), uint32(0)
+	for role, cnt := range c.Roles {
+		if !IsRegisteredRole(role) {
+			return errUnknownRole
+		}
+		if role != mafia_connection.Role_CIVILIAN {
+			special += cnt
+		}
+		if GetRole(role).Faction() == FactionMafia {
+			mafia += cnt
+		}
+	}
+	if mafia == 0 {
+		return errNoMafia
+	}
+	if special > c.MinPlayers {
+		return errTooManyRoles
+	}
+	if 2*mafia >= c.MinPlayers {
+		return errTooManyMafia
+	}
+	if c.DayDuration < 0 || c.NightDuration < 0 || c.BotWait < 0 || c.LastWordsDuration < 0 || c.SpeechDuration < 0 || c.RematchWait < 0 {
+		return errNegativeDuration
+	}
+	if c.MafiaVote == MafiaVoteConsensus && c.NightDuration <= 0 {
+		return errConsensusNoTimer
+	}
+	if len(c.Phases) > 0 {
+		seen := make(map[mafia_connection.State]bool)
+		for _, phase := range c.Phases {
+			if phase != mafia_connection.State_DAY && phase != mafia_connection.State_NIGHT {
+				return errUnknownPhase
+			}
+			seen[phase] = true
+		}
+		if len(c.Phases) != 2 || len(seen) != 2 {
+			return errPhaseOrder
+		}
+	}
+	if c.BotWait > 0 && !IsRegisteredBotStrategy(c.BotStrategy) {
+		return fmt.Errorf("unknown bot strategy '%s', expected one of: %s", c.BotStrategy, strings.Join(BotStrategyNames(), ", "))
+	}
+	return nil
+}
+
+func (c *RoomConfig) phaseDuration(state mafia_connection.State) time.Duration {
+	switch state {
+	case mafia_connection.State_DAY:
+		return c.DayDuration
+	case mafia_connection.State_NIGHT:
+		return c.NightDuration
+	default:
+		return 0
+	}
+}
+
+func (c *RoomConfig) firstPhase() mafia_connection.State {
+	if len(c.Phases) == 0 {
+		return mafia_connection.State_NIGHT
+	}
+	return c.Phases[0]
+}
+
+func (c *RoomConfig) nextPhase(state mafia_connection.State) mafia_connection.State {
+	if state == c.firstPhase() {
+		if len(c.Phases) == 0 {
+			return mafia_connection.State_DAY
+		}
+		return c.Phases[1]
+	}
+	return c.firstPhase()
+}
+
+func (c *RoomConfig) buildRoles(playersCnt int) []mafia_connection.Role {
+	roles := make([]mafia_connection.Role, 0, playersCnt)
+	for _, role := range sortedRoleKinds(c.Roles) {
+		if role == mafia_connection.Role_CIVILIAN {
+			continue
+		}
+		for i := uint32(0); i < c.Roles[role]; i++ {
+			roles = append(roles, role)
+		}
+	}
+	for len(roles) < playersCnt {
+		roles = append(roles, mafia_connection.Role_CIVILIAN)
+	}
+	return roles[:playersCnt]
+}
+
+var (
+	errTooFewPlayers    = errors.New("room needs at least 3 players")
+	errMinAboveMax      = errors.New("min players is greater than max players")
+	errUnknownRole      = errors.New("role is not registered")
+	errNoMafia          = errors.New("room needs at least one mafia")
+	errTooManyRoles     = errors.New("special roles don't fit into min players")
+	errTooManyMafia     = errors.New("mafia must be less than half of min players")
+	errNegativeDuration = errors.New("phase duration can't be negative")
+	errUnknownPhase     = errors.New("phase order may only contain day and night")
+	errPhaseOrder       = errors.New("phase order must list day and night once each")
+	errConsensusNoTimer = errors.New("consensus mafia vote needs a night duration for the fallback")
+)
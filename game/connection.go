@@ -0,0 +1,140 @@
+package game
+
+import (
+	"fmt"
+	mafia_connection "mafia/protos"
+)
+
+func (p *Player) send(action *mafia_connection.ServerAction) {
+	if p.disconnected || p.bot != nil {
+		return
+	}
+	p.connection.Send(action)
+}
+
+func (r *Room) isGameInProgress() bool {
+	return r.state != mafia_connection.State_NOT_STARTED && r.state != mafia_connection.State_END
+}
+
+func (r *Room) findPlayer(id uint64) *Player {
+	for _, p := range r.players {
+		if p.info.User.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+func (r *Room) Disconnect(user *mafia_connection.User, stream mafia_connection.MafiaService_RouteGameServer) bool {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	player := r.findPlayer(user.ID)
+	if player == nil || !r.isGameInProgress() {
+		return false
+	}
+	if player.connection != stream {
+		return true
+	}
+	player.disconnected = true
+	r.sendForAll(fmt.Sprintf("Player '%s' lost connection", user.Nickname))
+	return true
+}
+
+func (r *Room) IsDisconnected(user *mafia_connection.User) bool {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	player := r.findPlayer(user.ID)
+	return player != nil && player.disconnected && !player.left
+}
+
+// SendSession hands the session token to a member of the room. The room sends
+// on the member's stream from other goroutines, so it goes out under the lock.
+func (r *Room) SendSession(user *mafia_connection.User, session string) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	action := &mafia_connection.ServerAction{
+		Action: &mafia_connection.ServerAction_Session{Session: session},
+	}
+	if player := r.findPlayer(user.ID); player != nil {
+		player.send(action)
+		return
+	}
+	for _, spectator := range r.spectators {
+		if spectator.user.ID == user.ID {
+			spectator.connection.Send(action)
+			return
+		}
+	}
+}
+
+func (r *Room) Reconnect(user *mafia_connection.User, stream mafia_connection.MafiaService_RouteGameServer) bool {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	player := r.findPlayer(user.ID)
+	if player == nil || player.left {
+		return false
+	}
+	player.connection = stream
+	player.disconnected = false
+	r.sendForAll(fmt.Sprintf("Player '%s' reconnected to room '%d'", user.Nickname, r.ID))
+	return true
+}
+
+// leaveGame keeps the seat of a player who leaves a started game as a dead
+// one, so vote indices of the other players stay valid.
+func (r *Room) leaveGame(user *mafia_connection.User) {
+	player := r.findPlayer(user.ID)
+	if player == nil || player.left {
+		return
+	}
+	player.left = true
+	player.disconnected = true
+	player.rematch = false
+	r.record(&mafia_connection.GameEvent{
+		Type:  mafia_connection.EventType_LEAVE,
+		Actor: user,
+	})
+	if r.state == mafia_connection.State_END {
+		r.sendForAll(fmt.Sprintf("Player '%s' left the room '%d'", user.Nickname, r.ID))
+		if r.host == user.ID {
+			r.pickHost()
+		}
+		r.checkRematch()
+		return
+	}
+	wasAlive := player.info.Alive
+	player.info.Alive = false
+	player.voteFor = -1
+	for i, p := range r.players {
+		if p != player {
+			continue
+		}
+		for _, other := range r.players {
+			if other.voteFor == i {
+				other.voteFor = -1
+			}
+		}
+	}
+	r.sendForAll(fmt.Sprintf("Player '%s' left the game and is out%s", user.Nickname, r.deathReveal(player)))
+	if wasAlive && r.checkWinner() {
+		r.lastWords = nil
+		return
+	}
+	if r.isLastWords() {
+		if r.lastWords[0] == player {
+			r.lastWords = r.lastWords[1:]
+			r.nextLastWords(r.config.LastWordsDuration)
+		}
+		return
+	}
+	if r.isSpeeches() {
+		if r.speeches[0] == player {
+			r.speeches = r.speeches[1:]
+			r.nextSpeech(r.config.SpeechDuration)
+		}
+		return
+	}
+	if wasAlive {
+		r.checkAllVoted()
+	}
+}
@@ -0,0 +1,136 @@
+package game
+
+import (
+	"fmt"
+	mafia_connection "mafia/protos"
+	"mafia/utils"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type MafiaVote int
+
+const (
+	MafiaVotePlurality MafiaVote = iota
+	MafiaVoteConsensus
+)
+
+var mafiaVotes = namedEnum[MafiaVote]{kind: "mafia vote", names: []string{"plurality", "consensus"}}
+
+func (v MafiaVote) String() string {
+	return mafiaVotes.name(v)
+}
+
+func MafiaVoteNames() []string {
+	return mafiaVotes.list()
+}
+
+func ParseMafiaVote(name string) (MafiaVote, error) {
+	return mafiaVotes.parse(name)
+}
+
+type ConsensusFallback int
+
+const (
+	FallbackPlurality ConsensusFallback = iota
+	FallbackNone
+	FallbackRandom
+)
+
+var consensusFallbacks = namedEnum[ConsensusFallback]{kind: "consensus fallback", names: []string{"plurality", "none", "random"}}
+
+func (f ConsensusFallback) String() string {
+	return consensusFallbacks.name(f)
+}
+
+func ConsensusFallbackNames() []string {
+	return consensusFallbacks.list()
+}
+
+func ParseConsensusFallback(name string) (ConsensusFallback, error) {
+	return consensusFallbacks.parse(name)
+}
+
+// isConsensusKind tells whether the night picks of the role have to agree
+// before the kill resolves.
+func (r *Room) isConsensusKind(kind mafia_connection.Role) bool {
+	role := GetRole(kind)
+	return r.config.MafiaVote == MafiaVoteConsensus && role.Faction() == FactionMafia && role.NightAction() == NightActionKill
+}
+
+func (r *Room) inConsensus(p *Player) bool {
+	return r.state == mafia_connection.State_NIGHT && r.isConsensusKind(p.info.Role)
+}
+
+func (r *Room) consensusReached() bool {
+	target, first := -1, true
+	for _, p := range r.players {
+		if !r.waitsForVote(p) || !r.inConsensus(p) {
+			continue
+		}
+		if p.voteFor == -1 {
+			return false
+		}
+		if first {
+			target, first = p.voteFor, false
+		} else if p.voteFor != target {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Room) consensusFallback(votes []int) ([]int, string) {
+	switch r.config.ConsensusFallback {
+	case FallbackNone:
+		return nil, "No agreement, nobody was chosen"
+	case FallbackRandom:
+		picked := make([]int, 0)
+		for idx, cnt := range votes {
+			if cnt > 0 {
+				picked = append(picked, idx)
+			}
+		}
+		if len(picked) == 0 {
+			return nil, "No agreement, nobody was chosen"
+		}
+		chosen := picked[r.rng.Intn(len(picked))]
+		return []int{chosen}, fmt.Sprintf("No agreement, '%s' was chosen at random", r.players[chosen].info.User.Nickname)
+	default:
+		return utils.GetMaximumIndices(votes), "No agreement, the most picked target is chosen"
+	}
+}
+
+// teamPick is the pick a bot follows to reach consensus: a human ally's pick
+// comes first, then any other ally's.
+func (r *Room) teamPick(self *Player) int {
+	pick := -1
+	for _, p := range r.players {
+		if p == self || !r.waitsForVote(p) || !r.inConsensus(p) || p.voteFor < 0 {
+			continue
+		}
+		if p.bot == nil {
+			return p.voteFor
+		}
+		if pick == -1 {
+			pick = p.voteFor
+		}
+	}
+	return pick
+}
+
+func (r *Room) sendForFaction(faction Faction, message string) {
+	for _, player := range r.players {
+		if GetRole(player.info.Role).Faction() != faction {
+			continue
+		}
+		player.send(&mafia_connection.ServerAction{
+			Action: &mafia_connection.ServerAction_Event{
+				Event: &mafia_connection.RoomEvent{
+					Event:    wrapperspb.String(message),
+					RoomInfo: r.getRoomInfoForPlayer(player.info.User.ID),
+				},
+			},
+		})
+	}
+}
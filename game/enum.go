@@ -0,0 +1,33 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// namedEnum maps an enum to the names used in configs, rulesets and requests.
+// The first name is the default for values out of range.
+type namedEnum[T ~int] struct {
+	kind  string
+	names []string
+}
+
+func (e namedEnum[T]) name(value T) string {
+	if value < 0 || int(value) >= len(e.names) {
+		return e.names[0]
+	}
+	return e.names[value]
+}
+
+func (e namedEnum[T]) list() []string {
+	return append([]string(nil), e.names...)
+}
+
+func (e namedEnum[T]) parse(name string) (T, error) {
+	for i, enumName := range e.names {
+		if strings.EqualFold(strings.TrimSpace(name), enumName) {
+			return T(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown %s '%s', expected one of: %s", e.kind, name, strings.Join(e.names, ", "))
+}
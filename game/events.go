@@ -0,0 +1,168 @@
+package game
+
+import (
+	"fmt"
+	mafia_connection "mafia/protos"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Archive struct {
+	games map[uint64][]*mafia_connection.GameEvent
+	mux   sync.Mutex
+}
+
+func NewArchive() *Archive {
+	return &Archive{
+		games: make(map[uint64][]*mafia_connection.GameEvent),
+		mux:   sync.Mutex{},
+	}
+}
+
+func (a *Archive) Save(gameID uint64, events []*mafia_connection.GameEvent) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	a.games[gameID] = events
+}
+
+func (a *Archive) Get(gameID uint64) ([]*mafia_connection.GameEvent, bool) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	events, ok := a.games[gameID]
+	return events, ok
+}
+
+func (a *Archive) All() map[uint64][]*mafia_connection.GameEvent {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	games := make(map[uint64][]*mafia_connection.GameEvent, len(a.games))
+	for id, events := range a.games {
+		games[id] = events
+	}
+	return games
+}
+
+func (r *Room) record(event *mafia_connection.GameEvent) {
+	event.Seq = uint64(len(r.log))
+	event.Time = timestamppb.New(time.Now())
+	r.log = append(r.log, event)
+}
+
+func (r *Room) archiveLog() {
+	if r.archive == nil {
+		return
+	}
+	events := make([]*mafia_connection.GameEvent, len(r.log))
+	copy(events, r.log)
+	r.archive.Save(r.gameID, events)
+}
+
+func ReplayRoomInfo(roomID uint64, events []*mafia_connection.GameEvent) *mafia_connection.RoomInfo {
+	roomInfo := &mafia_connection.RoomInfo{
+		RoomID:  roomID,
+		State:   mafia_connection.State_NOT_STARTED,
+		Players: make([]*mafia_connection.Player, 0),
+	}
+	find := func(user *mafia_connection.User) *mafia_connection.Player {
+		if user == nil {
+			return nil
+		}
+		for _, p := range roomInfo.Players {
+			if p.User.ID == user.ID {
+				return p
+			}
+		}
+		return nil
+	}
+	for _, event := range events {
+		switch event.Type {
+		case mafia_connection.EventType_JOIN:
+			roomInfo.Players = append(roomInfo.Players, &mafia_connection.Player{
+				User:  event.Actor,
+				Role:  mafia_connection.Role_UNKNOWN,
+				Alive: true,
+				Bot:   event.Bot,
+			})
+		case mafia_connection.EventType_LEAVE:
+			if roomInfo.State != mafia_connection.State_NOT_STARTED {
+				if p := find(event.Actor); p != nil {
+					p.Alive = false
+				}
+				break
+			}
+			for i, p := range roomInfo.Players {
+				if p.User.ID == event.Actor.ID {
+					roomInfo.Players = append(roomInfo.Players[:i], roomInfo.Players[i+1:]...)
+					break
+				}
+			}
+		case mafia_connection.EventType_ROLE_ASSIGNED:
+			if p := find(event.Actor); p != nil {
+				p.Role = event.Role
+			}
+		case mafia_connection.EventType_KILL, mafia_connection.EventType_VOTED_OUT:
+			if p := find(event.Target); p != nil {
+				p.Alive = false
+			}
+		case mafia_connection.EventType_PHASE_CHANGE:
+			roomInfo.State = event.State
+		}
+	}
+	roomInfo.MaxPlayers = uint32(len(roomInfo.Players))
+	for i, p := range roomInfo.Players {
+		p.Seat = uint32(i + 1)
+	}
+	return roomInfo
+}
+
+func nickname(user *mafia_connection.User) string {
+	if user == nil {
+		return ""
+	}
+	return user.Nickname
+}
+
+func DescribeEvent(event *mafia_connection.GameEvent) string {
+	switch event.Type {
+	case mafia_connection.EventType_JOIN:
+		if event.Bot {
+			return fmt.Sprintf("Bot '%s' joined", nickname(event.Actor))
+		}
+		return fmt.Sprintf("Player '%s' joined", nickname(event.Actor))
+	case mafia_connection.EventType_LEAVE:
+		return fmt.Sprintf("Player '%s' left", nickname(event.Actor))
+	case mafia_connection.EventType_ROLE_ASSIGNED:
+		return fmt.Sprintf("'%s' got role %s", nickname(event.Actor), event.Role.String())
+	case mafia_connection.EventType_VOTE:
+		if event.State == mafia_connection.State_NIGHT {
+			return fmt.Sprintf("%s '%s' chose '%s'", RoleName(event.Role), nickname(event.Actor), nickname(event.Target))
+		}
+		return fmt.Sprintf("'%s' voted for '%s'", nickname(event.Actor), nickname(event.Target))
+	case mafia_connection.EventType_ABSTAIN:
+		return fmt.Sprintf("'%s' abstained", nickname(event.Actor))
+	case mafia_connection.EventType_RETRACT:
+		return fmt.Sprintf("'%s' retracted the choice", nickname(event.Actor))
+	case mafia_connection.EventType_LAST_WORDS:
+		return fmt.Sprintf("Last words of '%s': %s", nickname(event.Actor), event.Text)
+	case mafia_connection.EventType_NOMINATE:
+		return fmt.Sprintf("'%s' nominated '%s'", nickname(event.Actor), nickname(event.Target))
+	case mafia_connection.EventType_KILL:
+		return fmt.Sprintf("%s killed '%s'", RoleName(event.Role), nickname(event.Target))
+	case mafia_connection.EventType_HEAL:
+		return fmt.Sprintf("%s healed '%s'", RoleName(event.Role), nickname(event.Target))
+	case mafia_connection.EventType_CHECK:
+		return fmt.Sprintf("%s checked '%s'", RoleName(event.Role), nickname(event.Target))
+	case mafia_connection.EventType_SHOW:
+		return fmt.Sprintf("%s '%s' exposed '%s'", RoleName(event.Role), nickname(event.Actor), nickname(event.Target))
+	case mafia_connection.EventType_VOTED_OUT:
+		return fmt.Sprintf("The city voted out '%s'", nickname(event.Target))
+	case mafia_connection.EventType_PHASE_CHANGE:
+		return fmt.Sprintf("State changed to %s", event.State.String())
+	case mafia_connection.EventType_RESULT:
+		return fmt.Sprintf("Game finished, winner: %s", event.Winner)
+	default:
+		return event.Type.String()
+	}
+}
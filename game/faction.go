@@ -0,0 +1,60 @@
+package game
+
+type Faction int
+
+const (
+	FactionNone Faction = iota
+	FactionCity
+	FactionMafia
+	FactionManiac
+)
+
+func (f Faction) String() string {
+	switch f {
+	case FactionCity:
+		return "CITY"
+	case FactionMafia:
+		return "MAFIA"
+	case FactionManiac:
+		return "MANIAC"
+	default:
+		return "NONE"
+	}
+}
+
+type winRule struct {
+	faction Faction
+	message string
+	won     func(alive map[Faction]int, total int) bool
+}
+
+var winRules = []winRule{
+	{
+		faction: FactionNone,
+		message: "Nobody survived, it's a draw",
+		won: func(alive map[Faction]int, total int) bool {
+			return total == 0
+		},
+	},
+	{
+		faction: FactionManiac,
+		message: "Maniac won",
+		won: func(alive map[Faction]int, total int) bool {
+			return alive[FactionManiac] > 0 && total <= 2
+		},
+	},
+	{
+		faction: FactionMafia,
+		message: "Mafia won",
+		won: func(alive map[Faction]int, total int) bool {
+			return alive[FactionManiac] == 0 && alive[FactionMafia] >= total-alive[FactionMafia]
+		},
+	},
+	{
+		faction: FactionCity,
+		message: "Civilians won",
+		won: func(alive map[Faction]int, total int) bool {
+			return alive[FactionMafia] == 0 && alive[FactionManiac] == 0
+		},
+	},
+}
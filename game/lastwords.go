@@ -0,0 +1,94 @@
+package game
+
+import (
+	"fmt"
+	mafia_connection "mafia/protos"
+	"time"
+)
+
+func (r *Room) isLastWords() bool {
+	return len(r.lastWords) > 0
+}
+
+func (r *Room) startLastWords(eliminated []*Player) bool {
+	if r.config.LastWordsDuration <= 0 || len(eliminated) == 0 {
+		return false
+	}
+	r.lastWords = eliminated
+	r.nextLastWords(r.config.LastWordsDuration)
+	return true
+}
+
+// nextLastWords gives the floor to the first speaker in the queue, skipping
+// bots and players who left, and moves the game on once the queue is empty.
+// A disconnected speaker keeps the turn since they may still reconnect.
+func (r *Room) nextLastWords(duration time.Duration) {
+	r.phase++
+	if r.phaseTimer != nil {
+		r.phaseTimer.Stop()
+		r.phaseTimer = nil
+	}
+	r.deadline = time.Time{}
+	for len(r.lastWords) > 0 && (r.lastWords[0].bot != nil || r.lastWords[0].left) {
+		r.lastWords = r.lastWords[1:]
+	}
+	if len(r.lastWords) == 0 {
+		r.lastWords = nil
+		r.changeStateAfterVotes()
+		return
+	}
+	if duration < time.Second {
+		duration = time.Second
+	}
+	phase := r.phase
+	r.deadline = time.Now().Add(duration)
+	r.phaseTimer = time.AfterFunc(duration, func() {
+		r.mux.Lock()
+		defer r.mux.Unlock()
+		if r.phase != phase {
+			return
+		}
+		r.lastWords = r.lastWords[1:]
+		r.nextLastWords(r.config.LastWordsDuration)
+	})
+	r.sendForAll(fmt.Sprintf("Last words of '%s'", r.lastWords[0].info.User.Nickname))
+}
+
+func (r *Room) getLastWordsSpeaker() *mafia_connection.User {
+	if !r.isLastWords() {
+		return nil
+	}
+	return r.lastWords[0].info.User
+}
+
+func (r *Room) LastWordsRequest(author *mafia_connection.User, text string) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if !r.isLastWords() || r.lastWords[0].info.User.ID != author.ID {
+		r.sendIncorrectRequestMessage(author)
+		return
+	}
+	if text != "" {
+		r.record(&mafia_connection.GameEvent{
+			Type:  mafia_connection.EventType_LAST_WORDS,
+			Actor: author,
+			Text:  text,
+		})
+		action := &mafia_connection.ServerAction{
+			Action: &mafia_connection.ServerAction_LastWords{
+				LastWords: &mafia_connection.LastWords{
+					Author: author,
+					Text:   text,
+				},
+			},
+		}
+		for _, p := range r.players {
+			p.send(action)
+		}
+		for _, spectator := range r.spectators {
+			spectator.connection.Send(action)
+		}
+	}
+	r.lastWords = r.lastWords[1:]
+	r.nextLastWords(r.config.LastWordsDuration)
+}
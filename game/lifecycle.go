@@ -0,0 +1,48 @@
+package game
+
+import (
+	"fmt"
+	mafia_connection "mafia/protos"
+)
+
+func (r *Room) State() mafia_connection.State {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return r.state
+}
+
+func (r *Room) IsEmpty() bool {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if len(r.spectators) > 0 {
+		return false
+	}
+	for _, p := range r.players {
+		if p.bot == nil && !p.left {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Room) Close() []*mafia_connection.User {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.phase++
+	if r.phaseTimer != nil {
+		r.phaseTimer.Stop()
+		r.phaseTimer = nil
+	}
+	if r.botFillTimer != nil {
+		r.botFillTimer.Stop()
+		r.botFillTimer = nil
+	}
+	r.sendInfoForAll(fmt.Sprintf("Room '%d' was closed", r.ID))
+	users := r.getSpectatorUsers()
+	for _, p := range r.players {
+		if p.bot == nil {
+			users = append(users, p.info.User)
+		}
+	}
+	return users
+}
@@ -0,0 +1,96 @@
+package game
+
+import (
+	"fmt"
+	mafia_connection "mafia/protos"
+)
+
+func (r *Room) hostPlayer() *Player {
+	if r.host == 0 {
+		return nil
+	}
+	return r.findPlayer(r.host)
+}
+
+func (r *Room) pickHost() {
+	r.host = 0
+	for _, p := range r.players {
+		if p.bot == nil && !p.left {
+			r.host = p.info.User.ID
+			r.sendForAll(fmt.Sprintf("Player '%s' is now the host", p.info.User.Nickname))
+			return
+		}
+	}
+}
+
+func (r *Room) allReady() bool {
+	for _, p := range r.players {
+		if !p.ready {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Room) ReadyRequest(author *mafia_connection.User, ready bool) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	player := r.findPlayer(author.ID)
+	if player == nil || r.state != mafia_connection.State_NOT_STARTED {
+		r.sendIncorrectRequestMessage(author)
+		return
+	}
+	player.ready = ready
+	if ready {
+		r.sendForAll(fmt.Sprintf("Player '%s' is ready", author.Nickname))
+	} else {
+		r.sendForAll(fmt.Sprintf("Player '%s' is not ready", author.Nickname))
+	}
+}
+
+func (r *Room) StartRequest(author *mafia_connection.User) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if r.state != mafia_connection.State_NOT_STARTED {
+		r.sendIncorrectRequestMessage(author)
+		return
+	}
+	if r.host != author.ID {
+		r.sendInfoForUser(author, "Only the host can start the game")
+		return
+	}
+	if len(r.players) < int(r.config.MinPlayers) {
+		r.sendInfoForUser(author, fmt.Sprintf("Not enough players: %d/%d", len(r.players), r.config.MinPlayers))
+		return
+	}
+	if !r.allReady() {
+		r.sendInfoForUser(author, "Not everyone is ready")
+		return
+	}
+	r.startGame()
+	r.sendForAll("Game started!")
+}
+
+func (r *Room) KickRequest(author *mafia_connection.User, target *mafia_connection.User) bool {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if r.state != mafia_connection.State_NOT_STARTED || r.host != author.ID || author.ID == target.ID {
+		r.sendIncorrectRequestMessage(author)
+		return false
+	}
+	for i, p := range r.players {
+		if p.info.User.ID != target.ID {
+			continue
+		}
+		r.sendInfoForUser(p.info.User, fmt.Sprintf("You were kicked from room '%d', Ctrl + D to leave", r.ID))
+		r.players = append(r.players[:i], r.players[i+1:]...)
+		r.record(&mafia_connection.GameEvent{
+			Type:  mafia_connection.EventType_LEAVE,
+			Actor: p.info.User,
+		})
+		r.sendForAll(fmt.Sprintf("Player '%s' was kicked by the host", p.info.User.Nickname))
+		return true
+	}
+	r.sendIncorrectRequestMessage(author)
+	return false
+}
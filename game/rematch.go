@@ -0,0 +1,132 @@
+package game
+
+import (
+	"fmt"
+	mafia_connection "mafia/protos"
+	"math/rand"
+	"time"
+)
+
+func (r *Room) RematchRequest(author *mafia_connection.User) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	player := r.findPlayer(author.ID)
+	if player == nil || player.bot != nil || r.state != mafia_connection.State_END {
+		r.sendIncorrectRequestMessage(author)
+		return
+	}
+	if player.rematch {
+		return
+	}
+	player.rematch = true
+	agreed, total := r.countRematch()
+	r.sendForAll(fmt.Sprintf("Player '%s' wants a rematch (%d/%d)", author.Nickname, agreed, total))
+	r.checkRematch()
+}
+
+func (r *Room) countRematch() (int, int) {
+	agreed, total := 0, 0
+	for _, p := range r.players {
+		if p.bot != nil || p.left {
+			continue
+		}
+		total++
+		if p.rematch {
+			agreed++
+		}
+	}
+	return agreed, total
+}
+
+// checkRematch starts the rematch once everybody agreed or the host and
+// enough players to fill the table opted in. Otherwise the rematch starts with
+// whoever opted in when the wait runs out.
+func (r *Room) checkRematch() {
+	agreed, total := r.countRematch()
+	if agreed == 0 {
+		return
+	}
+	host := r.hostPlayer()
+	if agreed == total || (host != nil && host.rematch && agreed >= int(r.config.MinPlayers)) {
+		r.rematch()
+		return
+	}
+	r.startRematchTimer()
+}
+
+func (r *Room) startRematchTimer() {
+	if r.config.RematchWait <= 0 || r.phaseTimer != nil {
+		return
+	}
+	phase := r.phase
+	r.deadline = time.Now().Add(r.config.RematchWait)
+	r.phaseTimer = time.AfterFunc(r.config.RematchWait, func() {
+		r.mux.Lock()
+		defer r.mux.Unlock()
+		if r.phase != phase || r.state != mafia_connection.State_END {
+			return
+		}
+		r.phaseTimer = nil
+		if agreed, _ := r.countRematch(); agreed > 0 {
+			r.rematch()
+		}
+	})
+}
+
+// OnRematch sets the handler told about every rematch along with the players
+// who didn't opt in and were released from the room.
+func (r *Room) OnRematch(handler func(room *Room, released []*mafia_connection.User)) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.onRematch = handler
+}
+
+func (r *Room) rematch() {
+	r.phase++
+	if r.phaseTimer != nil {
+		r.phaseTimer.Stop()
+		r.phaseTimer = nil
+	}
+	r.deadline = time.Time{}
+	r.state = mafia_connection.State_NOT_STARTED
+	r.seed = time.Now().UnixNano()
+	r.rng = rand.New(rand.NewSource(r.seed))
+	r.gameID = r.rng.Uint64()
+	r.gameStartedTime = time.Now()
+	r.day = 0
+	r.log = make([]*mafia_connection.GameEvent, 0)
+
+	players := make([]*Player, 0, len(r.players))
+	released := make([]*mafia_connection.User, 0)
+	for _, p := range r.players {
+		if p.bot == nil && !p.rematch && !p.left {
+			r.sendInfoForUser(p.info.User, fmt.Sprintf("Rematch in room '%d' started without you, Ctrl + D to leave", r.ID))
+			released = append(released, p.info.User)
+		}
+		if p.bot != nil || !p.rematch {
+			continue
+		}
+		p.voteFor = -1
+		p.checkedBy = make(map[mafia_connection.Role]bool)
+		p.checkTarget = nil
+		p.shownBySherif = false
+		p.ready = false
+		p.rematch = false
+		p.info.Role = mafia_connection.Role_UNKNOWN
+		p.info.Alive = true
+		players = append(players, p)
+		r.record(&mafia_connection.GameEvent{
+			Type:  mafia_connection.EventType_JOIN,
+			Actor: p.info.User,
+		})
+	}
+	r.players = players
+	r.sendForAll(fmt.Sprintf("Rematch in room '%d', waiting for players", r.ID))
+	if r.findPlayer(r.host) == nil {
+		r.pickHost()
+	}
+	r.startBotFillTimer()
+	if r.onRematch != nil {
+		r.onRematch(r, released)
+	}
+}
@@ -0,0 +1,75 @@
+package game
+
+import (
+	"fmt"
+	mafia_connection "mafia/protos"
+	"strings"
+)
+
+type RevealPolicy int
+
+const (
+	RevealNone RevealPolicy = iota
+	RevealFaction
+	RevealRole
+)
+
+var revealPolicies = namedEnum[RevealPolicy]{kind: "reveal policy", names: []string{"none", "faction", "role"}}
+
+func (p RevealPolicy) String() string {
+	return revealPolicies.name(p)
+}
+
+func RevealPolicyNames() []string {
+	return revealPolicies.list()
+}
+
+func ParseRevealPolicy(name string) (RevealPolicy, error) {
+	return revealPolicies.parse(name)
+}
+
+func factionName(role mafia_connection.Role) string {
+	if role == mafia_connection.Role_UNKNOWN {
+		return ""
+	}
+	return GetRole(role).Faction().String()
+}
+
+// playerView is what a viewer knows about the target during a game. Living
+// players, ghosts and spectators all go through it, so a dead player's role is
+// revealed to everyone in the same way.
+func (r *Room) playerView(viewer *Player, spectator bool, target *Player) *mafia_connection.Player {
+	view := &mafia_connection.Player{
+		User:  target.info.User,
+		Role:  mafia_connection.Role_UNKNOWN,
+		Alive: target.info.Alive,
+		Bot:   target.info.Bot,
+	}
+	switch {
+	case target == viewer || target.shownBySherif:
+		view.Role = target.info.Role
+	case spectator && r.config.RevealRolesToSpectators:
+		view.Role = target.info.Role
+	case viewer != nil && GetRole(viewer.info.Role).Sees(&viewer.info, &target.info, target.checkedBy):
+		view.Role = target.info.Role
+	case !target.info.Alive && r.config.RevealOnDeath == RevealRole:
+		view.Role = target.info.Role
+	}
+	view.Faction = factionName(view.Role)
+	if !target.info.Alive && r.config.RevealOnDeath == RevealFaction {
+		view.Faction = factionName(target.info.Role)
+	}
+	return view
+}
+
+// deathReveal is appended to the announcement of a death.
+func (r *Room) deathReveal(p *Player) string {
+	switch r.config.RevealOnDeath {
+	case RevealRole:
+		return fmt.Sprintf(" (%s)", strings.ToLower(p.info.Role.String()))
+	case RevealFaction:
+		return fmt.Sprintf(" (%s)", strings.ToLower(GetRole(p.info.Role).Faction().String()))
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,180 @@
+package game
+
+import (
+	mafia_connection "mafia/protos"
+	"sort"
+	"strings"
+)
+
+type NightAction int
+
+const (
+	NightActionNone NightAction = iota
+	NightActionKill
+	NightActionCheck
+	NightActionHeal
+)
+
+func (a NightAction) String() string {
+	switch a {
+	case NightActionKill:
+		return "kill"
+	case NightActionCheck:
+		return "check"
+	case NightActionHeal:
+		return "heal"
+	default:
+		return ""
+	}
+}
+
+type Role interface {
+	Kind() mafia_connection.Role
+	Faction() Faction
+	NightAction() NightAction
+	Sees(viewer *mafia_connection.Player, target *mafia_connection.Player, checkedBy map[mafia_connection.Role]bool) bool
+	CanTarget(self *mafia_connection.Player, target *mafia_connection.Player) bool
+}
+
+// Seeker is implemented by roles that check one player at night on top of
+// their night action and only learn whether the target has one particular role.
+type Seeker interface {
+	Seeks() mafia_connection.Role
+}
+
+var registeredRoles = make(map[mafia_connection.Role]Role)
+
+func RegisterRole(role Role) {
+	registeredRoles[role.Kind()] = role
+}
+
+func GetRole(kind mafia_connection.Role) Role {
+	role, ok := registeredRoles[kind]
+	if !ok {
+		return unknownRole{}
+	}
+	return role
+}
+
+func IsRegisteredRole(kind mafia_connection.Role) bool {
+	_, ok := registeredRoles[kind]
+	return ok
+}
+
+func RoleName(kind mafia_connection.Role) string {
+	name := strings.ToLower(kind.String())
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// nightTeam is the kind the night picks of the role are counted under, so all
+// mafia killers take part in the same kill.
+func nightTeam(kind mafia_connection.Role) mafia_connection.Role {
+	role := GetRole(kind)
+	if role.Faction() == FactionMafia && role.NightAction() == NightActionKill {
+		return mafia_connection.Role_MAFIA
+	}
+	return kind
+}
+
+func sortedRoleKinds[V any](kinds map[mafia_connection.Role]V) []mafia_connection.Role {
+	res := make([]mafia_connection.Role, 0, len(kinds))
+	for kind := range kinds {
+		res = append(res, kind)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res
+}
+
+type unknownRole struct{}
+
+func (unknownRole) Kind() mafia_connection.Role { return mafia_connection.Role_UNKNOWN }
+func (unknownRole) Faction() Faction            { return FactionNone }
+func (unknownRole) NightAction() NightAction    { return NightActionNone }
+func (unknownRole) Sees(viewer *mafia_connection.Player, target *mafia_connection.Player, checkedBy map[mafia_connection.Role]bool) bool {
+	return false
+}
+func (unknownRole) CanTarget(self *mafia_connection.Player, target *mafia_connection.Player) bool {
+	return false
+}
+
+type civilianRole struct{}
+
+func (civilianRole) Kind() mafia_connection.Role { return mafia_connection.Role_CIVILIAN }
+func (civilianRole) Faction() Faction            { return FactionCity }
+func (civilianRole) NightAction() NightAction    { return NightActionNone }
+func (civilianRole) Sees(viewer *mafia_connection.Player, target *mafia_connection.Player, checkedBy map[mafia_connection.Role]bool) bool {
+	return false
+}
+func (civilianRole) CanTarget(self *mafia_connection.Player, target *mafia_connection.Player) bool {
+	return false
+}
+
+type mafiaRole struct{}
+
+func (mafiaRole) Kind() mafia_connection.Role { return mafia_connection.Role_MAFIA }
+func (mafiaRole) Faction() Faction            { return FactionMafia }
+func (mafiaRole) NightAction() NightAction    { return NightActionKill }
+func (mafiaRole) Sees(viewer *mafia_connection.Player, target *mafia_connection.Player, checkedBy map[mafia_connection.Role]bool) bool {
+	return GetRole(target.Role).Faction() == FactionMafia
+}
+func (mafiaRole) CanTarget(self *mafia_connection.Player, target *mafia_connection.Player) bool {
+	return target.Alive
+}
+
+type donRole struct{}
+
+func (donRole) Kind() mafia_connection.Role  { return mafia_connection.Role_DON }
+func (donRole) Faction() Faction             { return FactionMafia }
+func (donRole) NightAction() NightAction     { return NightActionKill }
+func (donRole) Seeks() mafia_connection.Role { return mafia_connection.Role_SHERIFF }
+func (donRole) Sees(viewer *mafia_connection.Player, target *mafia_connection.Player, checkedBy map[mafia_connection.Role]bool) bool {
+	return GetRole(target.Role).Faction() == FactionMafia
+}
+func (donRole) CanTarget(self *mafia_connection.Player, target *mafia_connection.Player) bool {
+	return target.Alive
+}
+
+type sheriffRole struct{}
+
+func (sheriffRole) Kind() mafia_connection.Role { return mafia_connection.Role_SHERIFF }
+func (sheriffRole) Faction() Faction            { return FactionCity }
+func (sheriffRole) NightAction() NightAction    { return NightActionCheck }
+func (sheriffRole) Sees(viewer *mafia_connection.Player, target *mafia_connection.Player, checkedBy map[mafia_connection.Role]bool) bool {
+	return target.Role == mafia_connection.Role_SHERIFF || checkedBy[mafia_connection.Role_SHERIFF]
+}
+func (sheriffRole) CanTarget(self *mafia_connection.Player, target *mafia_connection.Player) bool {
+	return target.Alive
+}
+
+type doctorRole struct{}
+
+func (doctorRole) Kind() mafia_connection.Role { return mafia_connection.Role_DOCTOR }
+func (doctorRole) Faction() Faction            { return FactionCity }
+func (doctorRole) NightAction() NightAction    { return NightActionHeal }
+func (doctorRole) Sees(viewer *mafia_connection.Player, target *mafia_connection.Player, checkedBy map[mafia_connection.Role]bool) bool {
+	return target.Role == mafia_connection.Role_DOCTOR
+}
+func (doctorRole) CanTarget(self *mafia_connection.Player, target *mafia_connection.Player) bool {
+	return target.Alive
+}
+
+type maniacRole struct{}
+
+func (maniacRole) Kind() mafia_connection.Role { return mafia_connection.Role_MANIAC }
+func (maniacRole) Faction() Faction            { return FactionManiac }
+func (maniacRole) NightAction() NightAction    { return NightActionKill }
+func (maniacRole) Sees(viewer *mafia_connection.Player, target *mafia_connection.Player, checkedBy map[mafia_connection.Role]bool) bool {
+	return target.User.ID == viewer.User.ID
+}
+func (maniacRole) CanTarget(self *mafia_connection.Player, target *mafia_connection.Player) bool {
+	return target.Alive
+}
+
+func init() {
+	RegisterRole(civilianRole{})
+	RegisterRole(mafiaRole{})
+	RegisterRole(sheriffRole{})
+	RegisterRole(doctorRole{})
+	RegisterRole(maniacRole{})
+	RegisterRole(donRole{})
+}
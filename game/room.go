@@ -10,92 +10,193 @@ import (
 	"mafia/utils"
 	"math/rand"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type Player struct {
-	voteFor         int
-	checkedBySherif bool
-	shownBySherif   bool
-	connection      mafia_connection.MafiaService_RouteGameServer
-	info            mafia_connection.Player
+	voteFor       int
+	checkedBy     map[mafia_connection.Role]bool
+	checkTarget   *Player
+	shownBySherif bool
+	disconnected  bool
+	left          bool
+	ready         bool
+	rematch       bool
+	bot           BotStrategy
+	connection    mafia_connection.MafiaService_RouteGameServer
+	info          mafia_connection.Player
 }
 
 type Room struct {
-	ID              uint64
-	state           mafia_connection.State
-	players         []*Player
-	statsEndpoint   string
-	gameStartedTime time.Time
+	ID               uint64
+	Name             string
+	gameID           uint64
+	private          bool
+	inviteCode       string
+	state            mafia_connection.State
+	host             uint64
+	players          []*Player
+	spectators       []*Spectator
+	config           RoomConfig
+	statsEndpoint    string
+	gameStartedTime  time.Time
+	deadline         time.Time
+	phaseTimer       *time.Timer
+	phase            uint64
+	botFillTimer     *time.Timer
+	runoff           []int
+	lastWords        []*Player
+	day              int
+	speeches         []*Player
+	nominees         []int
+	speakerNominated bool
+	log              []*mafia_connection.GameEvent
+	archive          *Archive
+	seed             int64
+	rng              *rand.Rand
+	onRematch        func(room *Room, released []*mafia_connection.User)
 
 	mux sync.Mutex
 }
 
-func (r *Room) TryToAddPlayer(user *mafia_connection.User, stream mafia_connection.MafiaService_RouteGameServer) bool {
+func (r *Room) TryToAddPlayer(user *mafia_connection.User, stream mafia_connection.MafiaService_RouteGameServer, inviteCode string) bool {
 	r.mux.Lock()
 	defer r.mux.Unlock()
-	if len(r.players) < 4 && r.state == mafia_connection.State_NOT_STARTED {
+	if r.canAddPlayer(inviteCode) {
 		r.players = append(r.players, &Player{
-			voteFor:         -1,
-			checkedBySherif: false,
-			shownBySherif:   false,
-			connection:      stream,
+			voteFor:       -1,
+			checkedBy:     make(map[mafia_connection.Role]bool),
+			shownBySherif: false,
+			connection:    stream,
 			info: mafia_connection.Player{
 				User:  user,
 				Role:  mafia_connection.Role_UNKNOWN,
 				Alive: true,
 			},
 		})
+		r.record(&mafia_connection.GameEvent{
+			Type:  mafia_connection.EventType_JOIN,
+			Actor: user,
+		})
+		if r.host == 0 {
+			r.host = user.ID
+		}
+		r.startBotFillTimer()
 		return true
 	}
 	return false
 }
 
+func (r *Room) canAddPlayer(inviteCode string) bool {
+	if r.private && inviteCode != r.inviteCode {
+		return false
+	}
+	return len(r.players) < int(r.config.MaxPlayers) && r.state == mafia_connection.State_NOT_STARTED
+}
+
+func (r *Room) CanJoin(inviteCode string) bool {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return r.canAddPlayer(inviteCode)
+}
+
+func (r *Room) IsPrivate() bool {
+	return r.private
+}
+
+func (r *Room) InviteCode() string {
+	return r.inviteCode
+}
+
+func (r *Room) Seed() int64 {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return r.seed
+}
+
+func (r *Room) MakePrivate(inviteCode string) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.private = true
+	r.inviteCode = inviteCode
+}
+
+func (r *Room) Describe() *mafia_connection.RoomDescription {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return &mafia_connection.RoomDescription{
+		RoomID:        r.ID,
+		Name:          r.Name,
+		State:         r.state,
+		PlayersCount:  uint32(len(r.players)),
+		MaxPlayers:    r.config.MaxPlayers,
+		Private:       r.private,
+		TieBreak:      r.config.TieBreak.String(),
+		Rules:         r.config.Rules.String(),
+		Ruleset:       r.config.Ruleset,
+		RevealOnDeath: r.config.RevealOnDeath.String(),
+	}
+}
+
 func (r *Room) getRoomInfoForPlayer(id uint64) *mafia_connection.RoomInfo {
 	roomInfo := &mafia_connection.RoomInfo{}
 	roomInfo.RoomID = r.ID
 	roomInfo.State = r.state
+	roomInfo.MaxPlayers = r.config.MaxPlayers
+	roomInfo.MinPlayers = r.config.MinPlayers
+	roomInfo.Rules = r.config.Rules.String()
+	roomInfo.Day = uint32(r.day)
+	roomInfo.Chat = &mafia_connection.ChatPermissions{
+		Day:    r.config.Chat.Day,
+		Night:  r.config.Chat.Night,
+		Ghosts: r.config.Chat.Ghosts,
+	}
+	if host := r.hostPlayer(); host != nil {
+		roomInfo.Host = host.info.User
+	}
+	if !r.deadline.IsZero() {
+		roomInfo.Deadline = timestamppb.New(r.deadline)
+	}
 	players := make([]*mafia_connection.Player, len(r.players))
 	if r.state == mafia_connection.State_NOT_STARTED || r.state == mafia_connection.State_END {
 		for i := range r.players {
-			players[i] = &r.players[i].info
-		}
-	} else {
-		role := mafia_connection.Role_UNKNOWN
-		for i := range r.players {
-			if r.players[i].info.User.ID == id {
-				role = r.players[i].info.Role
+			players[i] = &mafia_connection.Player{
+				User:    r.players[i].info.User,
+				Role:    r.players[i].info.Role,
+				Faction: factionName(r.players[i].info.Role),
+				Alive:   r.players[i].info.Alive,
+				Bot:     r.players[i].info.Bot,
+				Ready:   r.players[i].ready,
+				Seat:    uint32(i + 1),
 			}
 		}
+	} else {
+		viewer := r.findPlayer(id)
+		spectator := r.isSpectator(id)
 		for i := range r.players {
-			rightRole := mafia_connection.Role_UNKNOWN
-			if r.players[i].info.User.ID == id || (r.players[i].info.Role == role && role != mafia_connection.Role_CIVILIAN) {
-				rightRole = role
-			}
-			if r.players[i].shownBySherif {
-				rightRole = r.players[i].info.Role
-			}
-			if r.players[i].checkedBySherif && role == mafia_connection.Role_SHERIFF {
-				rightRole = r.players[i].info.Role
-			}
-			players[i] = &mafia_connection.Player{
-				User:  r.players[i].info.User,
-				Role:  rightRole,
-				Alive: r.players[i].info.Alive,
-			}
+			players[i] = r.playerView(viewer, spectator, r.players[i])
+			players[i].Seat = uint32(i + 1)
 		}
 	}
 	roomInfo.Players = players
+	roomInfo.Spectators = r.getSpectatorUsers()
+	roomInfo.Runoff = r.getRunoffUsers()
+	roomInfo.Votes = r.getVotes(id)
+	roomInfo.LastWordsSpeaker = r.getLastWordsSpeaker()
+	roomInfo.Speaker = r.getSpeaker()
+	roomInfo.Nominees = r.getNomineeUsers()
 	return roomInfo
 }
 
 func (r *Room) sendMessageForUser(user *mafia_connection.User, message string) {
 	for _, player := range r.players {
 		if player.info.User.ID == user.ID {
-			player.connection.Send(&mafia_connection.ServerAction{
+			player.send(&mafia_connection.ServerAction{
 				Action: &mafia_connection.ServerAction_Event{
 					Event: &mafia_connection.RoomEvent{
 						Event:    wrapperspb.String(message),
@@ -114,26 +215,22 @@ func (r *Room) SendMessageForUser(user *mafia_connection.User, message string) {
 	r.sendMessageForUser(user, message)
 }
 
-func (r *Room) sendGameResult(isMafiaWon bool) error {
+func (r *Room) sendGameResult(winner Faction) error {
 	gamePlayers := make([]storage.Player, 0)
 	for _, player := range r.players {
-		isWinner := false
-		if player.info.Role == mafia_connection.Role_MAFIA && isMafiaWon {
-			isWinner = true
-		}
-		if player.info.Role != mafia_connection.Role_MAFIA && !isMafiaWon {
-			isWinner = true
-		}
 		gamePlayers = append(gamePlayers, storage.Player{
 			Nickname: player.info.User.Nickname,
-			IsWinner: isWinner,
+			IsWinner: winner != FactionNone && GetRole(player.info.Role).Faction() == winner,
+			IsBot:    player.info.Bot,
 			Role:     player.info.Role.String(),
 		})
 	}
 	gameInfo := storage.GameInfo{
-		Id:       r.ID,
+		Id:       r.gameID,
 		Duration: int64(time.Since(r.gameStartedTime)),
 		Players:  gamePlayers,
+		Winner:   winner.String(),
+		Seed:     r.seed,
 		Comments: make([]string, 0),
 	}
 	jsonData, err := json.Marshal(gameInfo)
@@ -158,100 +255,224 @@ func (r *Room) sendGameResult(isMafiaWon bool) error {
 }
 
 func (r *Room) changeStateAfterVotes() {
-	cntMafia := 0
-	cntNotMafia := 0
-	for _, p := range r.players {
-		if !p.info.Alive {
-			continue
-		}
-		if p.info.Role == mafia_connection.Role_MAFIA {
-			cntMafia++
-		} else {
-			cntNotMafia++
-		}
-	}
-	if cntMafia == 0 {
-		r.changeState(mafia_connection.State_END)
-		r.sendGameResult(false)
-		r.sendForAll("Civilians won")
-		return
-	}
-	if cntMafia == cntNotMafia {
-		r.changeState(mafia_connection.State_END)
-		r.sendGameResult(true)
-		r.sendForAll("Mafia won")
+	if r.checkWinner() {
 		return
 	}
-	if r.state == mafia_connection.State_DAY {
+	if r.config.nextPhase(r.state) == mafia_connection.State_NIGHT {
 		r.changeState(mafia_connection.State_NIGHT)
 		r.sendForAll("Night started")
 	} else {
 		r.changeState(mafia_connection.State_DAY)
 		r.sendForAll("Night ended")
+		if r.isSport() {
+			r.startSpeeches()
+		}
 	}
 }
 
+func (r *Room) checkWinner() bool {
+	alive := make(map[Faction]int)
+	total := 0
+	for _, p := range r.players {
+		if p.info.Alive {
+			alive[GetRole(p.info.Role).Faction()]++
+			total++
+		}
+	}
+	for _, rule := range winRules {
+		if rule.won(alive, total) {
+			r.changeState(mafia_connection.State_END)
+			r.record(&mafia_connection.GameEvent{
+				Type:   mafia_connection.EventType_RESULT,
+				Winner: rule.faction.String(),
+			})
+			r.archiveLog()
+			r.sendGameResult(rule.faction)
+			r.sendForAll(rule.message)
+			r.sendInfoForAll(fmt.Sprintf("Use game ID '%d' to replay this game", r.gameID))
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Room) waitsForVote(p *Player) bool {
+	if !p.info.Alive || r.isLastWords() || r.isSpeeches() {
+		return false
+	}
+	if r.state == mafia_connection.State_NIGHT {
+		action := GetRole(p.info.Role).NightAction()
+		return action != NightActionNone && !(action == NightActionKill && r.isFirstNight())
+	}
+	return r.state == mafia_connection.State_DAY
+}
+
 func (r *Room) checkAllVoted() {
+	for _, p := range r.players {
+		if r.waitsForVote(p) && p.voteFor == -1 {
+			return
+		}
+		if r.waitsForCheck(p) && p.checkTarget == nil {
+			return
+		}
+	}
+	if !r.consensusReached() {
+		return
+	}
+	r.resolvePhase()
+}
+
+func (r *Room) resolvePhase() {
 	var message string
+	var eliminated []*Player
 	if r.state == mafia_connection.State_NIGHT {
-		disclosureRequest := make([]int, len(r.players))
-		killRequest := make([]int, len(r.players))
-
+		requests := make(map[mafia_connection.Role][]int)
 		for _, p := range r.players {
-			if p.info.Role == mafia_connection.Role_MAFIA && p.info.Alive {
-				if p.voteFor == -1 {
-					return
-				}
-				killRequest[p.voteFor] += 1
+			if !r.waitsForVote(p) || p.voteFor < 0 {
+				continue
+			}
+			kind := nightTeam(p.info.Role)
+			if requests[kind] == nil {
+				requests[kind] = make([]int, len(r.players))
 			}
+			requests[kind][p.voteFor] += 1
 		}
 
-		for _, p := range r.players {
-			if p.info.Role == mafia_connection.Role_SHERIFF && p.info.Alive {
-				if p.voteFor == -1 {
-					return
+		kinds := sortedRoleKinds(requests)
+		targets := make(map[mafia_connection.Role][]*Player)
+		healed := make(map[*Player]bool)
+		for _, kind := range kinds {
+			chosen := utils.GetMaximumIndices(requests[kind])
+			if r.isConsensusKind(kind) && !r.consensusReached() {
+				var fallback string
+				chosen, fallback = r.consensusFallback(requests[kind])
+				r.sendInfoForRole(kind, fallback)
+			}
+			if len(chosen) > 1 {
+				var tie string
+				chosen, tie = r.breakTie(chosen, true)
+				r.sendInfoForRole(kind, tie)
+			}
+			for _, idx := range chosen {
+				target := r.players[idx]
+				targets[kind] = append(targets[kind], target)
+				if GetRole(kind).NightAction() == NightActionHeal {
+					healed[target] = true
+					r.record(&mafia_connection.GameEvent{
+						Type:   mafia_connection.EventType_HEAL,
+						Target: target.info.User,
+						Role:   kind,
+					})
 				}
-				disclosureRequest[p.voteFor] += 1
 			}
 		}
 
-		killed := r.players[utils.GetRandomMaximumIndex(killRequest)]
-		killed.info.Alive = false
-		disclosured := r.players[utils.GetRandomMaximumIndex(disclosureRequest)]
-		disclosured.checkedBySherif = true
-
-		message = fmt.Sprintf("Mafia killed '%s' that night", killed.info.User.Nickname)
+		killed := make([]*Player, 0)
+		killers := make(map[*Player][]string)
+		for _, kind := range kinds {
+			if GetRole(kind).NightAction() != NightActionKill {
+				continue
+			}
+			for _, target := range targets[kind] {
+				if healed[target] {
+					continue
+				}
+				if killers[target] == nil {
+					killed = append(killed, target)
+					r.record(&mafia_connection.GameEvent{
+						Type:   mafia_connection.EventType_KILL,
+						Target: target.info.User,
+						Role:   kind,
+					})
+				}
+				killers[target] = append(killers[target], RoleName(kind))
+			}
+		}
+		victims := make([]string, 0, len(killed))
+		for _, p := range killed {
+			p.info.Alive = false
+			victims = append(victims, fmt.Sprintf("%s killed '%s'%s", strings.Join(killers[p], " and "), p.info.User.Nickname, r.deathReveal(p)))
+		}
+		eliminated = killed
+		if len(victims) == 0 {
+			message = "Nobody died that night"
+		} else {
+			message = strings.Join(victims, ", ") + " that night"
+		}
 
-		for _, p := range r.players {
-			if p.info.Role == mafia_connection.Role_SHERIFF {
-				r.sendInfoForUser(p.info.User, fmt.Sprintf(
+		for _, kind := range kinds {
+			if GetRole(kind).NightAction() != NightActionCheck {
+				continue
+			}
+			for _, checked := range targets[kind] {
+				checked.checkedBy[kind] = true
+				r.record(&mafia_connection.GameEvent{
+					Type:   mafia_connection.EventType_CHECK,
+					Target: checked.info.User,
+					Role:   kind,
+				})
+				r.sendInfoForRole(kind, fmt.Sprintf(
 					"'%s' is %s",
-					disclosured.info.User.Nickname,
-					disclosured.info.Role.String(),
+					checked.info.User.Nickname,
+					checked.info.Role.String(),
 				))
 			}
 		}
+		r.resolveChecks()
 	} else {
 		voteRequest := make([]int, len(r.players))
 		for _, p := range r.players {
-			if p.info.Alive {
-				if p.voteFor == -1 {
-					return
-				}
+			if r.waitsForVote(p) && p.voteFor >= 0 {
 				voteRequest[p.voteFor] += 1
 			}
 		}
-		votedOut := r.players[utils.GetRandomMaximumIndex(voteRequest)]
-		votedOut.info.Alive = false
-		message = fmt.Sprintf("The city voted out '%s'", votedOut.info.User.Nickname)
+		votedOut := utils.GetMaximumIndices(voteRequest)
+		tie := ""
+		if len(votedOut) > 1 {
+			if r.config.TieBreak == TieBreakRunoff && r.runoff == nil {
+				r.startRunoff(votedOut)
+				return
+			}
+			if r.runoff != nil {
+				votedOut, tie = nil, fmt.Sprintf("Runoff between %s tied again, nobody was chosen", r.nicknames(votedOut))
+			} else {
+				votedOut, tie = r.breakTie(votedOut, false)
+			}
+		}
+		names := make([]string, 0, len(votedOut))
+		for _, idx := range votedOut {
+			player := r.players[idx]
+			player.info.Alive = false
+			eliminated = append(eliminated, player)
+			r.record(&mafia_connection.GameEvent{
+				Type:   mafia_connection.EventType_VOTED_OUT,
+				Target: player.info.User,
+			})
+			names = append(names, fmt.Sprintf("'%s'%s", player.info.User.Nickname, r.deathReveal(player)))
+		}
+		if len(names) == 0 {
+			message = "The city couldn't decide, nobody was voted out"
+		} else {
+			message = fmt.Sprintf("The city voted out %s", strings.Join(names, ", "))
+		}
+		if tie != "" {
+			message = tie + ". " + message
+		}
 	}
 	r.sendInfoForAll(message)
+	if r.startLastWords(eliminated) {
+		return
+	}
 	r.changeStateAfterVotes()
 }
 
 func (r *Room) VoteRequest(author *mafia_connection.User, target *mafia_connection.User) {
 	r.mux.Lock()
 	defer r.mux.Unlock()
+	r.voteRequest(author, target)
+}
+
+func (r *Room) voteRequest(author *mafia_connection.User, target *mafia_connection.User) {
 	var authorPlayer, targetPlayer *Player
 	targetId := -1
 	for i, p := range r.players {
@@ -263,27 +484,91 @@ func (r *Room) VoteRequest(author *mafia_connection.User, target *mafia_connecti
 			targetId = i
 		}
 	}
-	if !authorPlayer.info.Alive || !targetPlayer.info.Alive {
+	if authorPlayer == nil || targetPlayer == nil || !authorPlayer.info.Alive || !targetPlayer.info.Alive {
 		r.sendIncorrectRequestMessage(author)
 		return
 	}
-	if r.state == mafia_connection.State_END || r.state == mafia_connection.State_NOT_STARTED {
+	if r.state == mafia_connection.State_END || r.state == mafia_connection.State_NOT_STARTED || r.isLastWords() || r.isSpeeches() {
 		r.sendIncorrectRequestMessage(author)
 		return
 	}
 	if r.state == mafia_connection.State_NIGHT {
-		if authorPlayer.info.Role == mafia_connection.Role_CIVILIAN || authorPlayer.info.Role == mafia_connection.Role_UNKNOWN {
+		role := GetRole(authorPlayer.info.Role)
+		action := role.NightAction()
+		if action == NightActionNone || action == NightActionHeal || !role.CanTarget(&authorPlayer.info, &targetPlayer.info) {
+			r.sendIncorrectRequestMessage(author)
+			return
+		}
+		if action == NightActionKill && r.isFirstNight() {
 			r.sendIncorrectRequestMessage(author)
 			return
 		}
+	} else if !r.isCandidate(targetId) {
+		r.sendIncorrectRequestMessage(author)
+		return
+	}
+	previous := authorPlayer.voteFor
+	authorPlayer.voteFor = targetId
+	r.record(&mafia_connection.GameEvent{
+		Type:   mafia_connection.EventType_VOTE,
+		Actor:  authorPlayer.info.User,
+		Target: targetPlayer.info.User,
+		Role:   authorPlayer.info.Role,
+		State:  r.state,
+	})
+	r.announceVote(authorPlayer, previous)
+	r.checkAllVoted()
+}
+
+func (r *Room) HealRequest(author *mafia_connection.User, target *mafia_connection.User) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.healRequest(author, target)
+}
+
+func (r *Room) healRequest(author *mafia_connection.User, target *mafia_connection.User) {
+	var authorPlayer, targetPlayer *Player
+	targetId := -1
+	for i, p := range r.players {
+		if p.info.User.ID == author.ID {
+			authorPlayer = p
+		}
+		if p.info.User.ID == target.ID {
+			targetPlayer = p
+			targetId = i
+		}
+	}
+	if authorPlayer == nil || targetPlayer == nil {
+		r.sendIncorrectRequestMessage(author)
+		return
+	}
+	role := GetRole(authorPlayer.info.Role)
+	if !authorPlayer.info.Alive || role.NightAction() != NightActionHeal || !role.CanTarget(&authorPlayer.info, &targetPlayer.info) {
+		r.sendIncorrectRequestMessage(author)
+		return
+	}
+	if r.state != mafia_connection.State_NIGHT || r.isLastWords() {
+		r.sendIncorrectRequestMessage(author)
+		return
 	}
 	authorPlayer.voteFor = targetId
+	r.record(&mafia_connection.GameEvent{
+		Type:   mafia_connection.EventType_VOTE,
+		Actor:  authorPlayer.info.User,
+		Target: targetPlayer.info.User,
+		Role:   authorPlayer.info.Role,
+		State:  r.state,
+	})
 	r.checkAllVoted()
 }
 
 func (r *Room) ShowRequest(author *mafia_connection.User, target *mafia_connection.User) {
 	r.mux.Lock()
 	defer r.mux.Unlock()
+	r.showRequest(author, target)
+}
+
+func (r *Room) showRequest(author *mafia_connection.User, target *mafia_connection.User) {
 	var authorPlayer, targetPlayer *Player
 	for _, p := range r.players {
 		if p.info.User.ID == author.ID {
@@ -293,7 +578,19 @@ func (r *Room) ShowRequest(author *mafia_connection.User, target *mafia_connecti
 			targetPlayer = p
 		}
 	}
-	if !authorPlayer.info.Alive || authorPlayer.info.Role != mafia_connection.Role_SHERIFF {
+	if authorPlayer == nil || targetPlayer == nil {
+		r.sendIncorrectRequestMessage(author)
+		return
+	}
+	if !authorPlayer.info.Alive || GetRole(authorPlayer.info.Role).NightAction() != NightActionCheck {
+		r.sendIncorrectRequestMessage(author)
+		return
+	}
+	if _, ok := GetRole(authorPlayer.info.Role).(Seeker); ok {
+		r.sendIncorrectRequestMessage(author)
+		return
+	}
+	if !targetPlayer.checkedBy[authorPlayer.info.Role] {
 		r.sendIncorrectRequestMessage(author)
 		return
 	}
@@ -302,16 +599,24 @@ func (r *Room) ShowRequest(author *mafia_connection.User, target *mafia_connecti
 		return
 	}
 	targetPlayer.shownBySherif = true
+	r.record(&mafia_connection.GameEvent{
+		Type:   mafia_connection.EventType_SHOW,
+		Actor:  authorPlayer.info.User,
+		Target: targetPlayer.info.User,
+		Role:   authorPlayer.info.Role,
+	})
 	r.sendForAll(fmt.Sprintf(
-		"Sheriff '%s' checked '%s' at night and exposes that he is a mafia",
+		"%s '%s' checked '%s' at night and exposes that he is a %s",
+		RoleName(authorPlayer.info.Role),
 		author.Nickname,
 		target.Nickname,
+		strings.ToLower(targetPlayer.info.Role.String()),
 	))
 }
 
 func (r *Room) sendForAll(message string) {
 	for _, player := range r.players {
-		player.connection.Send(&mafia_connection.ServerAction{
+		player.send(&mafia_connection.ServerAction{
 			Action: &mafia_connection.ServerAction_Event{
 				Event: &mafia_connection.RoomEvent{
 					Event:    wrapperspb.String(message),
@@ -320,82 +625,173 @@ func (r *Room) sendForAll(message string) {
 			},
 		})
 	}
+	r.sendForSpectators(message)
 }
 
 func (r *Room) changeState(newState mafia_connection.State) {
 	r.state = newState
+	r.runoff = nil
+	r.speeches = nil
+	r.nominees = nil
+	if newState == mafia_connection.State_DAY {
+		r.day++
+	}
 	for _, p := range r.players {
 		p.voteFor = -1
+		p.checkTarget = nil
+	}
+	r.record(&mafia_connection.GameEvent{
+		Type:  mafia_connection.EventType_PHASE_CHANGE,
+		State: newState,
+	})
+	r.startPhaseTimer()
+	r.scheduleBots()
+}
+
+func (r *Room) startPhaseTimer() {
+	r.phase++
+	if r.phaseTimer != nil {
+		r.phaseTimer.Stop()
+		r.phaseTimer = nil
+	}
+	r.deadline = time.Time{}
+	duration := r.config.phaseDuration(r.state)
+	if duration <= 0 {
+		return
 	}
+	r.runPhaseTimer(duration)
+}
+
+func (r *Room) runPhaseTimer(duration time.Duration) {
+	if duration < time.Second {
+		duration = time.Second
+	}
+	phase := r.phase
+	r.deadline = time.Now().Add(duration)
+	r.phaseTimer = time.AfterFunc(duration, func() {
+		r.mux.Lock()
+		defer r.mux.Unlock()
+		if r.phase != phase {
+			return
+		}
+		r.sendInfoForAll("Time is up")
+		r.resolvePhase()
+	})
 }
 
 func (r *Room) startGame() {
 	r.gameStartedTime = time.Now()
-	r.changeState(mafia_connection.State_NIGHT)
-	roles := []mafia_connection.Role{
-		mafia_connection.Role_CIVILIAN,
-		mafia_connection.Role_CIVILIAN,
-		mafia_connection.Role_MAFIA,
-		mafia_connection.Role_SHERIFF,
-	}
-	rand.Shuffle(len(roles), func(i, j int) { roles[i], roles[j] = roles[j], roles[i] })
+	if r.botFillTimer != nil {
+		r.botFillTimer.Stop()
+		r.botFillTimer = nil
+	}
+	roles := r.config.buildRoles(len(r.players))
+	r.rng.Shuffle(len(roles), func(i, j int) { roles[i], roles[j] = roles[j], roles[i] })
 	for i := range r.players {
 		r.players[i].info.Role = roles[i]
+		r.record(&mafia_connection.GameEvent{
+			Type:  mafia_connection.EventType_ROLE_ASSIGNED,
+			Actor: r.players[i].info.User,
+			Role:  roles[i],
+		})
+	}
+	r.changeState(r.config.firstPhase())
+	if r.state == mafia_connection.State_DAY && r.isSport() {
+		r.startSpeeches()
+	}
+	if r.isFirstNight() {
+		r.sendInfoForAll("First night: mafia get to know each other, nobody can be killed")
 	}
 }
 
 func (r *Room) JoinRoom(user *mafia_connection.User) {
 	r.mux.Lock()
 	defer r.mux.Unlock()
-	r.sendForAll(fmt.Sprintf("Player '%s' joined room '%d'", user.Nickname, r.ID))
-	if len(r.players) == 4 {
-		r.startGame()
-		r.sendForAll("Game started!")
+	if r.isSpectator(user.ID) {
+		r.joinAsSpectator(user)
+		return
 	}
+	r.sendForAll(fmt.Sprintf("Player '%s' joined room '%d'", user.Nickname, r.ID))
 }
 
 func (r *Room) LeaveRoom(user *mafia_connection.User) {
 	r.mux.Lock()
 	defer r.mux.Unlock()
+	if r.removeSpectator(user.ID) {
+		r.sendForAll(fmt.Sprintf("Spectator '%s' left the room '%d'", user.Nickname, r.ID))
+		return
+	}
+	if r.state != mafia_connection.State_NOT_STARTED {
+		r.leaveGame(user)
+		return
+	}
 	r.sendForAll(fmt.Sprintf("Player '%s' left the room '%d'", user.Nickname, r.ID))
 	for i := range r.players {
 		if r.players[i].info.User.ID == user.ID {
 			r.players = append(r.players[:i], r.players[i+1:]...)
+			r.record(&mafia_connection.GameEvent{
+				Type:  mafia_connection.EventType_LEAVE,
+				Actor: user,
+			})
+			if r.host == user.ID {
+				r.pickHost()
+			}
 			return
 		}
 	}
 }
 
-func GetNewRoom(statsEndpoint string) *Room {
+func GetNewRoom(name string, statsEndpoint string, config RoomConfig, archive *Archive, seed int64) *Room {
+	rng := rand.New(rand.NewSource(seed))
+	id := rng.Uint64()
+	if name == "" {
+		name = fmt.Sprintf("Room %d", id%10000)
+	}
 	return &Room{
-		ID:              rand.Uint64(),
+		ID:              id,
+		Name:            name,
+		gameID:          id,
 		players:         make([]*Player, 0),
+		spectators:      make([]*Spectator, 0),
 		mux:             sync.Mutex{},
 		state:           mafia_connection.State_NOT_STARTED,
+		config:          config,
 		statsEndpoint:   statsEndpoint,
 		gameStartedTime: time.Now(),
+		log:             make([]*mafia_connection.GameEvent, 0),
+		archive:         archive,
+		seed:            seed,
+		rng:             rng,
 	}
 }
 
 func (r *Room) sendInfoForAll(message string) {
 	for _, player := range r.players {
-		player.connection.Send(&mafia_connection.ServerAction{
+		player.send(&mafia_connection.ServerAction{
 			Action: &mafia_connection.ServerAction_ServerMessage{
 				ServerMessage: message,
 			},
 		})
 	}
+	r.sendInfoForSpectators(message)
 }
 
 func (r *Room) sendInfoForUser(user *mafia_connection.User, message string) {
+	action := &mafia_connection.ServerAction{
+		Action: &mafia_connection.ServerAction_ServerMessage{
+			ServerMessage: message,
+		},
+	}
 	for _, player := range r.players {
 		if player.info.User.ID == user.ID {
-			player.connection.Send(&mafia_connection.ServerAction{
-				Action: &mafia_connection.ServerAction_ServerMessage{
-					ServerMessage: message,
-				},
-			})
-			break
+			player.send(action)
+			return
+		}
+	}
+	for _, spectator := range r.spectators {
+		if spectator.user.ID == user.ID {
+			spectator.connection.Send(action)
+			return
 		}
 	}
 }
@@ -0,0 +1,148 @@
+package game
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	mafia_connection "mafia/protos"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Ruleset is a named set of rules read from a file. JSON is a subset of YAML,
+// so both formats go through the same decoder. Omitted fields keep the values
+// of the config the ruleset is applied to.
+type Ruleset struct {
+	Name              string            `yaml:"name"`
+	Rules             string            `yaml:"rules"`
+	MinPlayers        uint32            `yaml:"min-players"`
+	MaxPlayers        uint32            `yaml:"max-players"`
+	Roles             map[string]uint32 `yaml:"roles"`
+	Phases            []string          `yaml:"phases"`
+	Timers            RulesetTimers     `yaml:"timers"`
+	TieBreak          string            `yaml:"tie-break"`
+	RevealOnDeath     string            `yaml:"reveal-on-death"`
+	Chat              *RulesetChat      `yaml:"chat"`
+	MafiaVote         string            `yaml:"mafia-vote"`
+	ConsensusFallback string            `yaml:"consensus-fallback"`
+}
+
+type RulesetTimers struct {
+	Day       time.Duration `yaml:"day"`
+	Night     time.Duration `yaml:"night"`
+	LastWords time.Duration `yaml:"last-words"`
+	Speech    time.Duration `yaml:"speech"`
+}
+
+type RulesetChat struct {
+	Day    bool `yaml:"day"`
+	Night  bool `yaml:"night"`
+	Ghosts bool `yaml:"ghosts"`
+}
+
+func ParseRuleset(data []byte) (*Ruleset, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	ruleset := &Ruleset{}
+	if err := decoder.Decode(ruleset); err != nil {
+		return nil, err
+	}
+	return ruleset, nil
+}
+
+func (rs *Ruleset) Apply(base RoomConfig) (RoomConfig, error) {
+	if rs.Name == "" {
+		return RoomConfig{}, errNoRulesetName
+	}
+	config := base
+	config.Ruleset = rs.Name
+	if rs.Rules != "" {
+		rules, err := ParseRules(rs.Rules)
+		if err != nil {
+			return RoomConfig{}, err
+		}
+		config.ApplyRules(rules)
+	}
+	if rs.MinPlayers != 0 {
+		config.MinPlayers = rs.MinPlayers
+	}
+	if rs.MaxPlayers != 0 {
+		config.MaxPlayers = rs.MaxPlayers
+	}
+	if len(rs.Roles) > 0 {
+		config.Roles = make(map[mafia_connection.Role]uint32)
+		for name, cnt := range rs.Roles {
+			role, err := ParseRole(name)
+			if err != nil {
+				return RoomConfig{}, err
+			}
+			config.Roles[role] += cnt
+		}
+	}
+	if len(rs.Phases) > 0 {
+		config.Phases = make([]mafia_connection.State, len(rs.Phases))
+		for i, name := range rs.Phases {
+			phase, err := ParsePhase(name)
+			if err != nil {
+				return RoomConfig{}, err
+			}
+			config.Phases[i] = phase
+		}
+	}
+	if rs.Timers.Day != 0 {
+		config.DayDuration = rs.Timers.Day
+	}
+	if rs.Timers.Night != 0 {
+		config.NightDuration = rs.Timers.Night
+	}
+	if rs.Timers.LastWords != 0 {
+		config.LastWordsDuration = rs.Timers.LastWords
+	}
+	if rs.Timers.Speech != 0 {
+		config.SpeechDuration = rs.Timers.Speech
+	}
+	if rs.TieBreak != "" {
+		tieBreak, err := ParseTieBreak(rs.TieBreak)
+		if err != nil {
+			return RoomConfig{}, err
+		}
+		config.TieBreak = tieBreak
+	}
+	if rs.RevealOnDeath != "" {
+		revealOnDeath, err := ParseRevealPolicy(rs.RevealOnDeath)
+		if err != nil {
+			return RoomConfig{}, err
+		}
+		config.RevealOnDeath = revealOnDeath
+	}
+	if rs.Chat != nil {
+		config.Chat = ChatPermissions{
+			Day:    rs.Chat.Day,
+			Night:  rs.Chat.Night,
+			Ghosts: rs.Chat.Ghosts,
+		}
+	}
+	if rs.MafiaVote != "" {
+		mafiaVote, err := ParseMafiaVote(rs.MafiaVote)
+		if err != nil {
+			return RoomConfig{}, err
+		}
+		config.MafiaVote = mafiaVote
+	}
+	if rs.ConsensusFallback != "" {
+		fallback, err := ParseConsensusFallback(rs.ConsensusFallback)
+		if err != nil {
+			return RoomConfig{}, err
+		}
+		config.ConsensusFallback = fallback
+	}
+	if err := config.Validate(); err != nil {
+		return RoomConfig{}, fmt.Errorf("ruleset '%s': %w", rs.Name, err)
+	}
+	return config, nil
+}
+
+var (
+	errNoRulesetName = errors.New("ruleset has no name")
+)
@@ -0,0 +1,202 @@
+package game
+
+import (
+	mafia_connection "mafia/protos"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+type PlayerSnapshot struct {
+	ID            uint64                  `json:"id"`
+	Nickname      string                  `json:"nickname"`
+	Role          mafia_connection.Role   `json:"role"`
+	Alive         bool                    `json:"alive"`
+	Bot           bool                    `json:"bot"`
+	VoteFor       int                     `json:"voteFor"`
+	CheckedBy     []mafia_connection.Role `json:"checkedBy"`
+	CheckTarget   uint64                  `json:"checkTarget,omitempty"`
+	ShownBySherif bool                    `json:"shownBySherif"`
+	Ready         bool                    `json:"ready"`
+	Left          bool                    `json:"left"`
+}
+
+type RoomSnapshot struct {
+	ID              uint64                        `json:"id"`
+	Name            string                        `json:"name"`
+	GameID          uint64                        `json:"gameId"`
+	Private         bool                          `json:"private"`
+	InviteCode      string                        `json:"inviteCode"`
+	State           mafia_connection.State        `json:"state"`
+	Host            uint64                        `json:"host"`
+	Players         []PlayerSnapshot              `json:"players"`
+	Config          RoomConfig                    `json:"config"`
+	Seed            int64                         `json:"seed"`
+	GameStartedTime time.Time                     `json:"gameStartedTime"`
+	Deadline        time.Time                     `json:"deadline"`
+	Log             []*mafia_connection.GameEvent `json:"log"`
+	LastWords       []uint64                      `json:"lastWords"`
+	Day             int                           `json:"day"`
+	Speeches        []uint64                      `json:"speeches"`
+	Nominees        []int                         `json:"nominees"`
+	Runoff          []int                         `json:"runoff"`
+}
+
+func (r *Room) Snapshot() *RoomSnapshot {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	players := make([]PlayerSnapshot, 0, len(r.players))
+	for _, p := range r.players {
+		checkedBy := make([]mafia_connection.Role, 0, len(p.checkedBy))
+		for kind := range p.checkedBy {
+			checkedBy = append(checkedBy, kind)
+		}
+		var checkTarget uint64
+		if p.checkTarget != nil {
+			checkTarget = p.checkTarget.info.User.ID
+		}
+		players = append(players, PlayerSnapshot{
+			ID:            p.info.User.ID,
+			Nickname:      p.info.User.Nickname,
+			Role:          p.info.Role,
+			Alive:         p.info.Alive,
+			Bot:           p.info.Bot,
+			VoteFor:       p.voteFor,
+			CheckedBy:     checkedBy,
+			CheckTarget:   checkTarget,
+			ShownBySherif: p.shownBySherif,
+			Left:          p.left,
+			Ready:         p.ready,
+		})
+	}
+	log := make([]*mafia_connection.GameEvent, len(r.log))
+	copy(log, r.log)
+	lastWords := make([]uint64, len(r.lastWords))
+	for i, p := range r.lastWords {
+		lastWords[i] = p.info.User.ID
+	}
+	speeches := make([]uint64, len(r.speeches))
+	for i, p := range r.speeches {
+		speeches[i] = p.info.User.ID
+	}
+	return &RoomSnapshot{
+		ID:              r.ID,
+		Name:            r.Name,
+		GameID:          r.gameID,
+		Private:         r.private,
+		InviteCode:      r.inviteCode,
+		State:           r.state,
+		Host:            r.host,
+		Players:         players,
+		Config:          r.config,
+		Seed:            r.seed,
+		GameStartedTime: r.gameStartedTime,
+		Deadline:        r.deadline,
+		Log:             log,
+		LastWords:       lastWords,
+		Day:             r.day,
+		Speeches:        speeches,
+		Nominees:        append([]int(nil), r.nominees...),
+		Runoff:          append([]int(nil), r.runoff...),
+	}
+}
+
+func RestoreRoom(snapshot *RoomSnapshot, statsEndpoint string, archive *Archive) *Room {
+	// The generator state itself can't be saved, so continue from a value derived from the seed
+	rng := rand.New(rand.NewSource(snapshot.Seed + int64(len(snapshot.Log))))
+	r := &Room{
+		ID:              snapshot.ID,
+		Name:            snapshot.Name,
+		gameID:          snapshot.GameID,
+		private:         snapshot.Private,
+		inviteCode:      snapshot.InviteCode,
+		state:           snapshot.State,
+		host:            snapshot.Host,
+		players:         make([]*Player, 0, len(snapshot.Players)),
+		spectators:      make([]*Spectator, 0),
+		config:          snapshot.Config,
+		statsEndpoint:   statsEndpoint,
+		gameStartedTime: snapshot.GameStartedTime,
+		log:             snapshot.Log,
+		archive:         archive,
+		seed:            snapshot.Seed,
+		rng:             rng,
+		day:             snapshot.Day,
+		nominees:        snapshot.Nominees,
+		runoff:          snapshot.Runoff,
+		mux:             sync.Mutex{},
+	}
+	if r.log == nil {
+		r.log = make([]*mafia_connection.GameEvent, 0)
+	}
+	for _, p := range snapshot.Players {
+		player := &Player{
+			voteFor:       p.VoteFor,
+			checkedBy:     make(map[mafia_connection.Role]bool),
+			shownBySherif: p.ShownBySherif,
+			left:          p.Left,
+			disconnected:  !p.Bot,
+			ready:         p.Ready,
+			info: mafia_connection.Player{
+				User: &mafia_connection.User{
+					ID:       p.ID,
+					Nickname: p.Nickname,
+				},
+				Role:  p.Role,
+				Alive: p.Alive,
+				Bot:   p.Bot,
+			},
+		}
+		for _, kind := range p.CheckedBy {
+			player.checkedBy[kind] = true
+		}
+		if p.Bot {
+			factory, ok := botStrategies[r.config.BotStrategy]
+			if !ok {
+				factory = botStrategies["random"]
+			}
+			player.bot = factory(rng)
+		}
+		r.players = append(r.players, player)
+	}
+	for i, p := range snapshot.Players {
+		if p.CheckTarget != 0 {
+			r.players[i].checkTarget = r.findPlayer(p.CheckTarget)
+		}
+	}
+	for _, id := range snapshot.LastWords {
+		if p := r.findPlayer(id); p != nil {
+			r.lastWords = append(r.lastWords, p)
+		}
+	}
+	for _, id := range snapshot.Speeches {
+		if p := r.findPlayer(id); p != nil {
+			r.speeches = append(r.speeches, p)
+		}
+	}
+	if r.isLastWords() {
+		r.nextLastWords(time.Until(snapshot.Deadline))
+	} else if r.isSpeeches() {
+		r.nextSpeech(time.Until(snapshot.Deadline))
+	} else if r.isGameInProgress() {
+		if !snapshot.Deadline.IsZero() {
+			r.runPhaseTimer(time.Until(snapshot.Deadline))
+		}
+		r.scheduleBots()
+	} else if r.state == mafia_connection.State_NOT_STARTED && len(r.players) > 0 {
+		r.startBotFillTimer()
+	}
+	return r
+}
+
+func (r *Room) HumanPlayers() []*mafia_connection.User {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	users := make([]*mafia_connection.User, 0, len(r.players))
+	for _, p := range r.players {
+		if p.bot == nil && !p.left {
+			users = append(users, p.info.User)
+		}
+	}
+	return users
+}
@@ -0,0 +1,93 @@
+package game
+
+import (
+	"fmt"
+	mafia_connection "mafia/protos"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type Spectator struct {
+	connection mafia_connection.MafiaService_RouteGameServer
+	user       *mafia_connection.User
+}
+
+func (r *Room) canAddSpectator(inviteCode string) bool {
+	if r.private && inviteCode != r.inviteCode {
+		return false
+	}
+	return r.state != mafia_connection.State_END
+}
+
+func (r *Room) CanSpectate(inviteCode string) bool {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return r.canAddSpectator(inviteCode)
+}
+
+func (r *Room) TryToAddSpectator(user *mafia_connection.User, stream mafia_connection.MafiaService_RouteGameServer, inviteCode string) bool {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if !r.canAddSpectator(inviteCode) {
+		return false
+	}
+	r.spectators = append(r.spectators, &Spectator{
+		connection: stream,
+		user:       user,
+	})
+	return true
+}
+
+func (r *Room) isSpectator(id uint64) bool {
+	for _, spectator := range r.spectators {
+		if spectator.user.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Room) removeSpectator(id uint64) bool {
+	for i, spectator := range r.spectators {
+		if spectator.user.ID == id {
+			r.spectators = append(r.spectators[:i], r.spectators[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Room) getSpectatorUsers() []*mafia_connection.User {
+	users := make([]*mafia_connection.User, len(r.spectators))
+	for i, spectator := range r.spectators {
+		users[i] = spectator.user
+	}
+	return users
+}
+
+func (r *Room) sendForSpectators(message string) {
+	for _, spectator := range r.spectators {
+		spectator.connection.Send(&mafia_connection.ServerAction{
+			Action: &mafia_connection.ServerAction_Event{
+				Event: &mafia_connection.RoomEvent{
+					Event:    wrapperspb.String(message),
+					RoomInfo: r.getRoomInfoForPlayer(spectator.user.ID),
+				},
+			},
+		})
+	}
+}
+
+func (r *Room) sendInfoForSpectators(message string) {
+	for _, spectator := range r.spectators {
+		spectator.connection.Send(&mafia_connection.ServerAction{
+			Action: &mafia_connection.ServerAction_ServerMessage{
+				ServerMessage: message,
+			},
+		})
+	}
+}
+
+func (r *Room) joinAsSpectator(user *mafia_connection.User) {
+	r.sendForAll(fmt.Sprintf("Spectator '%s' joined room '%d'", user.Nickname, r.ID))
+}
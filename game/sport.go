@@ -0,0 +1,297 @@
+package game
+
+import (
+	"fmt"
+	mafia_connection "mafia/protos"
+	"time"
+)
+
+type Rules int
+
+const (
+	RulesCasual Rules = iota
+	RulesSport
+)
+
+var allRules = namedEnum[Rules]{kind: "rules", names: []string{"casual", "sport"}}
+
+func (r Rules) String() string {
+	return allRules.name(r)
+}
+
+func RulesNames() []string {
+	return allRules.list()
+}
+
+func ParseRules(name string) (Rules, error) {
+	return allRules.parse(name)
+}
+
+// seating is the table a config had before sport rules replaced it.
+type seating struct {
+	minPlayers uint32
+	maxPlayers uint32
+	roles      map[mafia_connection.Role]uint32
+}
+
+// ApplyRules switches the config to the given rules. Sport games always have
+// ten seats with a don, two mafia and a sheriff, and switching back restores
+// the seats and roles the config had before.
+func (c *RoomConfig) ApplyRules(rules Rules) {
+	if rules == RulesSport && c.Rules != RulesSport {
+		c.casualSeating = &seating{minPlayers: c.MinPlayers, maxPlayers: c.MaxPlayers, roles: c.Roles}
+	}
+	if rules != RulesSport && c.Rules == RulesSport && c.casualSeating != nil {
+		c.MinPlayers = c.casualSeating.minPlayers
+		c.MaxPlayers = c.casualSeating.maxPlayers
+		c.Roles = make(map[mafia_connection.Role]uint32, len(c.casualSeating.roles))
+		for kind, cnt := range c.casualSeating.roles {
+			c.Roles[kind] = cnt
+		}
+		c.casualSeating = nil
+	}
+	c.Rules = rules
+	if rules != RulesSport {
+		return
+	}
+	c.MinPlayers = SPORT_PLAYERS
+	c.MaxPlayers = SPORT_PLAYERS
+	c.Roles = map[mafia_connection.Role]uint32{
+		mafia_connection.Role_DON:     1,
+		mafia_connection.Role_MAFIA:   2,
+		mafia_connection.Role_SHERIFF: 1,
+	}
+}
+
+func (r *Room) isSport() bool {
+	return r.config.Rules == RulesSport
+}
+
+func (r *Room) isFirstNight() bool {
+	return r.isSport() && r.state == mafia_connection.State_NIGHT && r.day == 0
+}
+
+func (r *Room) isSpeeches() bool {
+	return len(r.speeches) > 0
+}
+
+// startSpeeches lines up the living players in seat order. The opening seat
+// moves one further every day.
+func (r *Room) startSpeeches() {
+	r.speeches = make([]*Player, 0, len(r.players))
+	r.nominees = make([]int, 0)
+	start := (r.day - 1) % len(r.players)
+	for i := range r.players {
+		p := r.players[(start+i)%len(r.players)]
+		if p.info.Alive {
+			r.speeches = append(r.speeches, p)
+		}
+	}
+	r.nextSpeech(r.config.SpeechDuration)
+}
+
+func (r *Room) nextSpeech(duration time.Duration) {
+	r.phase++
+	if r.phaseTimer != nil {
+		r.phaseTimer.Stop()
+		r.phaseTimer = nil
+	}
+	r.deadline = time.Time{}
+	r.speakerNominated = false
+	// A disconnected speaker keeps the seat until the reconnect grace period
+	// runs out and they leave, which also covers players of a restored room.
+	for len(r.speeches) > 0 && (r.speeches[0].bot != nil || !r.speeches[0].info.Alive) {
+		if r.speeches[0].bot != nil && r.speeches[0].info.Alive {
+			r.nominateByBot(r.speeches[0])
+		}
+		r.speeches = r.speeches[1:]
+	}
+	if len(r.speeches) == 0 {
+		r.speeches = nil
+		r.startNomineesVote()
+		return
+	}
+	if duration < time.Second {
+		duration = time.Second
+	}
+	phase := r.phase
+	r.deadline = time.Now().Add(duration)
+	r.phaseTimer = time.AfterFunc(duration, func() {
+		r.mux.Lock()
+		defer r.mux.Unlock()
+		if r.phase != phase {
+			return
+		}
+		r.speeches = r.speeches[1:]
+		r.nextSpeech(r.config.SpeechDuration)
+	})
+	speaker := r.speeches[0]
+	r.sendForAll(fmt.Sprintf("Speech of seat %d '%s'", r.seatOf(speaker), speaker.info.User.Nickname))
+}
+
+func (r *Room) startNomineesVote() {
+	if len(r.nominees) == 0 {
+		r.sendInfoForAll("Nobody was nominated")
+		r.changeStateAfterVotes()
+		return
+	}
+	r.startPhaseTimer()
+	r.sendForAll(fmt.Sprintf("Voting between nominees %s", r.nicknames(r.nominees)))
+	r.scheduleBots()
+}
+
+func (r *Room) nominateByBot(p *Player) {
+	self := r.seatOf(p) - 1
+	target := p.bot.DayVote(r.getRoomInfoForPlayer(p.info.User.ID), self)
+	if target == -1 || r.isNominee(target) {
+		return
+	}
+	r.nominate(p, target)
+}
+
+func (r *Room) nominate(author *Player, target int) {
+	r.nominees = append(r.nominees, target)
+	r.speakerNominated = true
+	r.record(&mafia_connection.GameEvent{
+		Type:   mafia_connection.EventType_NOMINATE,
+		Actor:  author.info.User,
+		Target: r.players[target].info.User,
+	})
+	r.sendForAll(fmt.Sprintf("Player '%s' nominated '%s'", author.info.User.Nickname, r.players[target].info.User.Nickname))
+}
+
+func (r *Room) isNominee(idx int) bool {
+	for _, nominee := range r.nominees {
+		if nominee == idx {
+			return true
+		}
+	}
+	return false
+}
+
+// isCandidate tells whether the day vote may target the player, which is
+// limited by a runoff and, in sport games, by the nominations.
+func (r *Room) isCandidate(idx int) bool {
+	if r.isSport() && !r.isNominee(idx) {
+		return false
+	}
+	return r.inRunoff(idx)
+}
+
+func (r *Room) getCandidates() []int {
+	if r.runoff != nil {
+		return r.runoff
+	}
+	if r.isSport() {
+		return r.nominees
+	}
+	return nil
+}
+
+func (r *Room) seatOf(player *Player) int {
+	for i, p := range r.players {
+		if p == player {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func (r *Room) getSpeaker() *mafia_connection.User {
+	if !r.isSpeeches() {
+		return nil
+	}
+	return r.speeches[0].info.User
+}
+
+func (r *Room) getNomineeUsers() []*mafia_connection.User {
+	users := make([]*mafia_connection.User, len(r.nominees))
+	for i, idx := range r.nominees {
+		users[i] = r.players[idx].info.User
+	}
+	return users
+}
+
+func (r *Room) NominateRequest(author *mafia_connection.User, target *mafia_connection.User) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if !r.isSpeeches() || r.speeches[0].info.User.ID != author.ID || r.speakerNominated {
+		r.sendIncorrectRequestMessage(author)
+		return
+	}
+	for i, p := range r.players {
+		if p.info.User.ID != target.ID {
+			continue
+		}
+		if !p.info.Alive || r.isNominee(i) {
+			break
+		}
+		r.nominate(r.speeches[0], i)
+		return
+	}
+	r.sendIncorrectRequestMessage(author)
+}
+
+func (r *Room) EndSpeechRequest(author *mafia_connection.User) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if !r.isSpeeches() || r.speeches[0].info.User.ID != author.ID {
+		r.sendIncorrectRequestMessage(author)
+		return
+	}
+	r.speeches = r.speeches[1:]
+	r.nextSpeech(r.config.SpeechDuration)
+}
+
+func (r *Room) waitsForCheck(p *Player) bool {
+	_, ok := GetRole(p.info.Role).(Seeker)
+	return ok && p.info.Alive && r.state == mafia_connection.State_NIGHT && !r.isLastWords()
+}
+
+func (r *Room) resolveChecks() {
+	for _, p := range r.players {
+		seeker, ok := GetRole(p.info.Role).(Seeker)
+		if !ok || p.checkTarget == nil {
+			continue
+		}
+		checked := p.checkTarget
+		checked.checkedBy[p.info.Role] = true
+		r.record(&mafia_connection.GameEvent{
+			Type:   mafia_connection.EventType_CHECK,
+			Actor:  p.info.User,
+			Target: checked.info.User,
+			Role:   p.info.Role,
+		})
+		verdict := "is not"
+		if checked.info.Role == seeker.Seeks() {
+			verdict = "is"
+		}
+		r.sendInfoForUser(p.info.User, fmt.Sprintf("'%s' %s %s", checked.info.User.Nickname, verdict, seeker.Seeks().String()))
+	}
+}
+
+func (r *Room) CheckRequest(author *mafia_connection.User, target *mafia_connection.User) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.checkRequest(author, target)
+}
+
+func (r *Room) checkRequest(author *mafia_connection.User, target *mafia_connection.User) {
+	authorPlayer := r.findPlayer(author.ID)
+	targetPlayer := r.findPlayer(target.ID)
+	if authorPlayer == nil || targetPlayer == nil || authorPlayer == targetPlayer || !r.waitsForCheck(authorPlayer) {
+		r.sendIncorrectRequestMessage(author)
+		return
+	}
+	if !GetRole(authorPlayer.info.Role).CanTarget(&authorPlayer.info, &targetPlayer.info) {
+		r.sendIncorrectRequestMessage(author)
+		return
+	}
+	authorPlayer.checkTarget = targetPlayer
+	r.sendInfoForUser(author, fmt.Sprintf("You will check '%s' tonight", targetPlayer.info.User.Nickname))
+	r.checkAllVoted()
+}
+
+const (
+	SPORT_PLAYERS = 10
+)
@@ -0,0 +1,95 @@
+package game
+
+import (
+	"fmt"
+	mafia_connection "mafia/protos"
+	"strings"
+)
+
+type TieBreak int
+
+const (
+	TieBreakRandom TieBreak = iota
+	TieBreakNone
+	TieBreakRunoff
+	TieBreakAll
+)
+
+var tieBreaks = namedEnum[TieBreak]{kind: "tie-break policy", names: []string{"random", "none", "runoff", "all"}}
+
+func (t TieBreak) String() string {
+	return tieBreaks.name(t)
+}
+
+func TieBreakNames() []string {
+	return tieBreaks.list()
+}
+
+func ParseTieBreak(name string) (TieBreak, error) {
+	return tieBreaks.parse(name)
+}
+
+func (r *Room) nicknames(ids []int) string {
+	names := make([]string, len(ids))
+	for i, id := range ids {
+		names[i] = fmt.Sprintf("'%s'", r.players[id].info.User.Nickname)
+	}
+	return strings.Join(names, ", ")
+}
+
+// breakTie picks who is affected by a tied vote. At night there is no time for a
+// runoff, so a runoff policy leaves the tied role without an action.
+func (r *Room) breakTie(tied []int, night bool) ([]int, string) {
+	tie := fmt.Sprintf("Tie between %s", r.nicknames(tied))
+	policy := r.config.TieBreak
+	if night && policy == TieBreakRunoff {
+		policy = TieBreakNone
+	}
+	switch policy {
+	case TieBreakNone:
+		return nil, tie + ", nobody was chosen"
+	case TieBreakAll:
+		return tied, tie + ", all of them were chosen"
+	default:
+		chosen := tied[r.rng.Intn(len(tied))]
+		return []int{chosen}, fmt.Sprintf("%s, '%s' was chosen at random", tie, r.players[chosen].info.User.Nickname)
+	}
+}
+
+func (r *Room) startRunoff(tied []int) {
+	r.runoff = tied
+	for _, p := range r.players {
+		p.voteFor = -1
+	}
+	r.startPhaseTimer()
+	r.sendInfoForAll(fmt.Sprintf("Tie between %s, runoff vote between them", r.nicknames(tied)))
+	r.scheduleBots()
+}
+
+func (r *Room) inRunoff(idx int) bool {
+	if r.runoff == nil {
+		return true
+	}
+	for _, candidate := range r.runoff {
+		if candidate == idx {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Room) getRunoffUsers() []*mafia_connection.User {
+	users := make([]*mafia_connection.User, len(r.runoff))
+	for i, idx := range r.runoff {
+		users[i] = r.players[idx].info.User
+	}
+	return users
+}
+
+func (r *Room) sendInfoForRole(kind mafia_connection.Role, message string) {
+	for _, p := range r.players {
+		if nightTeam(p.info.Role) == kind {
+			r.sendInfoForUser(p.info.User, message)
+		}
+	}
+}
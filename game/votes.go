@@ -0,0 +1,108 @@
+package game
+
+import (
+	"fmt"
+	mafia_connection "mafia/protos"
+)
+
+const (
+	voteAbstain = -2
+)
+
+// announceVote makes day votes public. Consensus picks at night are only
+// shown to the mafia, and bot allies get a chance to follow a human's pick.
+func (r *Room) announceVote(player *Player, previous int) {
+	switch {
+	case r.state == mafia_connection.State_DAY:
+		r.sendForAll(r.describeVote(player, previous))
+	case r.inConsensus(player):
+		r.sendForFaction(FactionMafia, r.describeVote(player, previous))
+		if player.bot == nil {
+			r.scheduleBots()
+		}
+	}
+}
+
+func (r *Room) describeVote(player *Player, previous int) string {
+	nickname := player.info.User.Nickname
+	switch {
+	case player.voteFor == voteAbstain:
+		return fmt.Sprintf("Player '%s' abstained", nickname)
+	case player.voteFor == -1:
+		return fmt.Sprintf("Player '%s' retracted the vote", nickname)
+	case previous != -1:
+		return fmt.Sprintf("Player '%s' changed the vote to '%s'", nickname, r.players[player.voteFor].info.User.Nickname)
+	default:
+		return fmt.Sprintf("Player '%s' voted for '%s'", nickname, r.players[player.voteFor].info.User.Nickname)
+	}
+}
+
+func (r *Room) getVotes(id uint64) []*mafia_connection.Vote {
+	votes := make([]*mafia_connection.Vote, 0)
+	counts := r.waitsForVote
+	if r.state == mafia_connection.State_NIGHT {
+		viewer := r.findPlayer(id)
+		if viewer == nil || GetRole(viewer.info.Role).Faction() != FactionMafia {
+			return votes
+		}
+		counts = func(p *Player) bool {
+			return r.waitsForVote(p) && r.inConsensus(p)
+		}
+	} else if r.state != mafia_connection.State_DAY {
+		return votes
+	}
+	for _, p := range r.players {
+		switch {
+		case !counts(p) || p.voteFor == -1:
+			continue
+		case p.voteFor == voteAbstain:
+			votes = append(votes, &mafia_connection.Vote{Voter: p.info.User, Abstain: true})
+		default:
+			votes = append(votes, &mafia_connection.Vote{Voter: p.info.User, Target: r.players[p.voteFor].info.User})
+		}
+	}
+	return votes
+}
+
+func (r *Room) AbstainRequest(author *mafia_connection.User) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.abstainRequest(author)
+}
+
+func (r *Room) abstainRequest(author *mafia_connection.User) {
+	player := r.findPlayer(author.ID)
+	if player == nil || !r.waitsForVote(player) {
+		r.sendIncorrectRequestMessage(author)
+		return
+	}
+	previous := player.voteFor
+	player.voteFor = voteAbstain
+	r.record(&mafia_connection.GameEvent{
+		Type:  mafia_connection.EventType_ABSTAIN,
+		Actor: player.info.User,
+		Role:  player.info.Role,
+		State: r.state,
+	})
+	r.announceVote(player, previous)
+	r.checkAllVoted()
+}
+
+func (r *Room) RetractRequest(author *mafia_connection.User) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	player := r.findPlayer(author.ID)
+	if player == nil || !r.waitsForVote(player) || player.voteFor == -1 {
+		r.sendIncorrectRequestMessage(author)
+		return
+	}
+	previous := player.voteFor
+	player.voteFor = -1
+	r.record(&mafia_connection.GameEvent{
+		Type:  mafia_connection.EventType_RETRACT,
+		Actor: player.info.User,
+		Role:  player.info.Role,
+		State: r.state,
+	})
+	r.announceVote(player, previous)
+}
@@ -7,6 +7,8 @@
 package mafia_connection
 
 import (
+	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -28,6 +30,9 @@ const (
 	Role_MAFIA    Role = 1
 	Role_SHERIFF  Role = 2
 	Role_CIVILIAN Role = 3
+	Role_DOCTOR   Role = 4
+	Role_MANIAC   Role = 5
+	Role_DON      Role = 6
 )
 
 // Enum value maps for Role.
@@ -37,12 +42,18 @@ var (
 		1: "MAFIA",
 		2: "SHERIFF",
 		3: "CIVILIAN",
+		4: "DOCTOR",
+		5: "MANIAC",
+		6: "DON",
 	}
 	Role_value = map[string]int32{
 		"UNKNOWN":  0,
 		"MAFIA":    1,
 		"SHERIFF":  2,
 		"CIVILIAN": 3,
+		"DOCTOR":   4,
+		"MANIAC":   5,
+		"DON":      6,
 	}
 )
 
@@ -125,6 +136,91 @@ func (State) EnumDescriptor() ([]byte, []int) {
 	return file_protos_connection_proto_rawDescGZIP(), []int{1}
 }
 
+type EventType int32
+
+const (
+	EventType_JOIN          EventType = 0
+	EventType_LEAVE         EventType = 1
+	EventType_ROLE_ASSIGNED EventType = 2
+	EventType_VOTE          EventType = 3
+	EventType_KILL          EventType = 4
+	EventType_HEAL          EventType = 5
+	EventType_CHECK         EventType = 6
+	EventType_SHOW          EventType = 7
+	EventType_VOTED_OUT     EventType = 8
+	EventType_PHASE_CHANGE  EventType = 9
+	EventType_RESULT        EventType = 10
+	EventType_ABSTAIN       EventType = 11
+	EventType_RETRACT       EventType = 12
+	EventType_LAST_WORDS    EventType = 13
+	EventType_NOMINATE      EventType = 14
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0:  "JOIN",
+		1:  "LEAVE",
+		2:  "ROLE_ASSIGNED",
+		3:  "VOTE",
+		4:  "KILL",
+		5:  "HEAL",
+		6:  "CHECK",
+		7:  "SHOW",
+		8:  "VOTED_OUT",
+		9:  "PHASE_CHANGE",
+		10: "RESULT",
+		11: "ABSTAIN",
+		12: "RETRACT",
+		13: "LAST_WORDS",
+		14: "NOMINATE",
+	}
+	EventType_value = map[string]int32{
+		"JOIN":          0,
+		"LEAVE":         1,
+		"ROLE_ASSIGNED": 2,
+		"VOTE":          3,
+		"KILL":          4,
+		"HEAL":          5,
+		"CHECK":         6,
+		"SHOW":          7,
+		"VOTED_OUT":     8,
+		"PHASE_CHANGE":  9,
+		"RESULT":        10,
+		"ABSTAIN":       11,
+		"RETRACT":       12,
+		"LAST_WORDS":    13,
+		"NOMINATE":      14,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_connection_proto_enumTypes[2].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_protos_connection_proto_enumTypes[2]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_protos_connection_proto_rawDescGZIP(), []int{2}
+}
+
 type User struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -240,9 +336,13 @@ type Player struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User  *User `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
-	Role  Role  `protobuf:"varint,2,opt,name=Role,proto3,enum=Mafia.Connection.Role" json:"Role,omitempty"`
-	Alive bool  `protobuf:"varint,3,opt,name=Alive,proto3" json:"Alive,omitempty"`
+	User    *User  `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	Role    Role   `protobuf:"varint,2,opt,name=Role,proto3,enum=Mafia.Connection.Role" json:"Role,omitempty"`
+	Alive   bool   `protobuf:"varint,3,opt,name=Alive,proto3" json:"Alive,omitempty"`
+	Bot     bool   `protobuf:"varint,4,opt,name=Bot,proto3" json:"Bot,omitempty"`
+	Ready   bool   `protobuf:"varint,5,opt,name=Ready,proto3" json:"Ready,omitempty"`
+	Seat    uint32 `protobuf:"varint,6,opt,name=Seat,proto3" json:"Seat,omitempty"`
+	Faction string `protobuf:"bytes,7,opt,name=Faction,proto3" json:"Faction,omitempty"`
 }
 
 func (x *Player) Reset() {
@@ -298,18 +398,46 @@ func (x *Player) GetAlive() bool {
 	return false
 }
 
-type RoomInfo struct {
+func (x *Player) GetBot() bool {
+	if x != nil {
+		return x.Bot
+	}
+	return false
+}
+
+func (x *Player) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *Player) GetSeat() uint32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *Player) GetFaction() string {
+	if x != nil {
+		return x.Faction
+	}
+	return ""
+}
+
+type ChatPermissions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomID  uint64    `protobuf:"varint,1,opt,name=RoomID,proto3" json:"RoomID,omitempty"`
-	State   State     `protobuf:"varint,2,opt,name=State,proto3,enum=Mafia.Connection.State" json:"State,omitempty"`
-	Players []*Player `protobuf:"bytes,3,rep,name=Players,proto3" json:"Players,omitempty"`
+	Day    bool `protobuf:"varint,1,opt,name=Day,proto3" json:"Day,omitempty"`
+	Night  bool `protobuf:"varint,2,opt,name=Night,proto3" json:"Night,omitempty"`
+	Ghosts bool `protobuf:"varint,3,opt,name=Ghosts,proto3" json:"Ghosts,omitempty"`
 }
 
-func (x *RoomInfo) Reset() {
-	*x = RoomInfo{}
+func (x *ChatPermissions) Reset() {
+	*x = ChatPermissions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_connection_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -317,13 +445,13 @@ func (x *RoomInfo) Reset() {
 	}
 }
 
-func (x *RoomInfo) String() string {
+func (x *ChatPermissions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoomInfo) ProtoMessage() {}
+func (*ChatPermissions) ProtoMessage() {}
 
-func (x *RoomInfo) ProtoReflect() protoreflect.Message {
+func (x *ChatPermissions) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_connection_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -335,43 +463,44 @@ func (x *RoomInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoomInfo.ProtoReflect.Descriptor instead.
-func (*RoomInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChatPermissions.ProtoReflect.Descriptor instead.
+func (*ChatPermissions) Descriptor() ([]byte, []int) {
 	return file_protos_connection_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *RoomInfo) GetRoomID() uint64 {
+func (x *ChatPermissions) GetDay() bool {
 	if x != nil {
-		return x.RoomID
+		return x.Day
 	}
-	return 0
+	return false
 }
 
-func (x *RoomInfo) GetState() State {
+func (x *ChatPermissions) GetNight() bool {
 	if x != nil {
-		return x.State
+		return x.Night
 	}
-	return State_NOT_STARTED
+	return false
 }
 
-func (x *RoomInfo) GetPlayers() []*Player {
+func (x *ChatPermissions) GetGhosts() bool {
 	if x != nil {
-		return x.Players
+		return x.Ghosts
 	}
-	return nil
+	return false
 }
 
-type RoomEvent struct {
+type Vote struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Event    *wrappers.StringValue `protobuf:"bytes,1,opt,name=Event,proto3" json:"Event,omitempty"`
-	RoomInfo *RoomInfo             `protobuf:"bytes,2,opt,name=RoomInfo,proto3" json:"RoomInfo,omitempty"`
+	Voter   *User `protobuf:"bytes,1,opt,name=Voter,proto3" json:"Voter,omitempty"`
+	Target  *User `protobuf:"bytes,2,opt,name=Target,proto3" json:"Target,omitempty"`
+	Abstain bool  `protobuf:"varint,3,opt,name=Abstain,proto3" json:"Abstain,omitempty"`
 }
 
-func (x *RoomEvent) Reset() {
-	*x = RoomEvent{}
+func (x *Vote) Reset() {
+	*x = Vote{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_connection_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -379,13 +508,13 @@ func (x *RoomEvent) Reset() {
 	}
 }
 
-func (x *RoomEvent) String() string {
+func (x *Vote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoomEvent) ProtoMessage() {}
+func (*Vote) ProtoMessage() {}
 
-func (x *RoomEvent) ProtoReflect() protoreflect.Message {
+func (x *Vote) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_connection_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -397,40 +526,57 @@ func (x *RoomEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoomEvent.ProtoReflect.Descriptor instead.
-func (*RoomEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use Vote.ProtoReflect.Descriptor instead.
+func (*Vote) Descriptor() ([]byte, []int) {
 	return file_protos_connection_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *RoomEvent) GetEvent() *wrappers.StringValue {
+func (x *Vote) GetVoter() *User {
 	if x != nil {
-		return x.Event
+		return x.Voter
 	}
 	return nil
 }
 
-func (x *RoomEvent) GetRoomInfo() *RoomInfo {
+func (x *Vote) GetTarget() *User {
 	if x != nil {
-		return x.RoomInfo
+		return x.Target
 	}
 	return nil
 }
 
-type PlayerAction struct {
+func (x *Vote) GetAbstain() bool {
+	if x != nil {
+		return x.Abstain
+	}
+	return false
+}
+
+type RoomInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Action:
-	//
-	//	*PlayerAction_Connetion
-	//	*PlayerAction_Vote
-	//	*PlayerAction_Show
-	Action isPlayerAction_Action `protobuf_oneof:"Action"`
+	RoomID           uint64               `protobuf:"varint,1,opt,name=RoomID,proto3" json:"RoomID,omitempty"`
+	State            State                `protobuf:"varint,2,opt,name=State,proto3,enum=Mafia.Connection.State" json:"State,omitempty"`
+	Players          []*Player            `protobuf:"bytes,3,rep,name=Players,proto3" json:"Players,omitempty"`
+	MaxPlayers       uint32               `protobuf:"varint,4,opt,name=MaxPlayers,proto3" json:"MaxPlayers,omitempty"`
+	Deadline         *timestamp.Timestamp `protobuf:"bytes,5,opt,name=Deadline,proto3" json:"Deadline,omitempty"`
+	Spectators       []*User              `protobuf:"bytes,6,rep,name=Spectators,proto3" json:"Spectators,omitempty"`
+	Host             *User                `protobuf:"bytes,7,opt,name=Host,proto3" json:"Host,omitempty"`
+	MinPlayers       uint32               `protobuf:"varint,8,opt,name=MinPlayers,proto3" json:"MinPlayers,omitempty"`
+	Runoff           []*User              `protobuf:"bytes,9,rep,name=Runoff,proto3" json:"Runoff,omitempty"`
+	Votes            []*Vote              `protobuf:"bytes,10,rep,name=Votes,proto3" json:"Votes,omitempty"`
+	LastWordsSpeaker *User                `protobuf:"bytes,11,opt,name=LastWordsSpeaker,proto3" json:"LastWordsSpeaker,omitempty"`
+	Rules            string               `protobuf:"bytes,12,opt,name=Rules,proto3" json:"Rules,omitempty"`
+	Day              uint32               `protobuf:"varint,13,opt,name=Day,proto3" json:"Day,omitempty"`
+	Speaker          *User                `protobuf:"bytes,14,opt,name=Speaker,proto3" json:"Speaker,omitempty"`
+	Nominees         []*User              `protobuf:"bytes,15,rep,name=Nominees,proto3" json:"Nominees,omitempty"`
+	Chat             *ChatPermissions     `protobuf:"bytes,16,opt,name=Chat,proto3" json:"Chat,omitempty"`
 }
 
-func (x *PlayerAction) Reset() {
-	*x = PlayerAction{}
+func (x *RoomInfo) Reset() {
+	*x = RoomInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_connection_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -438,13 +584,13 @@ func (x *PlayerAction) Reset() {
 	}
 }
 
-func (x *PlayerAction) String() string {
+func (x *RoomInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerAction) ProtoMessage() {}
+func (*RoomInfo) ProtoMessage() {}
 
-func (x *PlayerAction) ProtoReflect() protoreflect.Message {
+func (x *RoomInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_connection_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -456,75 +602,143 @@ func (x *PlayerAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerAction.ProtoReflect.Descriptor instead.
-func (*PlayerAction) Descriptor() ([]byte, []int) {
+// Deprecated: Use RoomInfo.ProtoReflect.Descriptor instead.
+func (*RoomInfo) Descriptor() ([]byte, []int) {
 	return file_protos_connection_proto_rawDescGZIP(), []int{5}
 }
 
-func (m *PlayerAction) GetAction() isPlayerAction_Action {
-	if m != nil {
-		return m.Action
+func (x *RoomInfo) GetRoomID() uint64 {
+	if x != nil {
+		return x.RoomID
+	}
+	return 0
+}
+
+func (x *RoomInfo) GetState() State {
+	if x != nil {
+		return x.State
+	}
+	return State_NOT_STARTED
+}
+
+func (x *RoomInfo) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
 	}
 	return nil
 }
 
-func (x *PlayerAction) GetConnetion() *User {
-	if x, ok := x.GetAction().(*PlayerAction_Connetion); ok {
-		return x.Connetion
+func (x *RoomInfo) GetMaxPlayers() uint32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *RoomInfo) GetDeadline() *timestamp.Timestamp {
+	if x != nil {
+		return x.Deadline
 	}
 	return nil
 }
 
-func (x *PlayerAction) GetVote() *User {
-	if x, ok := x.GetAction().(*PlayerAction_Vote); ok {
-		return x.Vote
+func (x *RoomInfo) GetSpectators() []*User {
+	if x != nil {
+		return x.Spectators
 	}
 	return nil
 }
 
-func (x *PlayerAction) GetShow() *User {
-	if x, ok := x.GetAction().(*PlayerAction_Show); ok {
-		return x.Show
+func (x *RoomInfo) GetHost() *User {
+	if x != nil {
+		return x.Host
 	}
 	return nil
 }
 
-type isPlayerAction_Action interface {
-	isPlayerAction_Action()
+func (x *RoomInfo) GetMinPlayers() uint32 {
+	if x != nil {
+		return x.MinPlayers
+	}
+	return 0
 }
 
-type PlayerAction_Connetion struct {
-	Connetion *User `protobuf:"bytes,1,opt,name=Connetion,proto3,oneof"`
+func (x *RoomInfo) GetRunoff() []*User {
+	if x != nil {
+		return x.Runoff
+	}
+	return nil
 }
 
-type PlayerAction_Vote struct {
-	Vote *User `protobuf:"bytes,2,opt,name=Vote,proto3,oneof"`
+func (x *RoomInfo) GetVotes() []*Vote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
 }
 
-type PlayerAction_Show struct {
-	Show *User `protobuf:"bytes,3,opt,name=Show,proto3,oneof"`
+func (x *RoomInfo) GetLastWordsSpeaker() *User {
+	if x != nil {
+		return x.LastWordsSpeaker
+	}
+	return nil
 }
 
-func (*PlayerAction_Connetion) isPlayerAction_Action() {}
+func (x *RoomInfo) GetRules() string {
+	if x != nil {
+		return x.Rules
+	}
+	return ""
+}
 
-func (*PlayerAction_Vote) isPlayerAction_Action() {}
+func (x *RoomInfo) GetDay() uint32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
 
-func (*PlayerAction_Show) isPlayerAction_Action() {}
+func (x *RoomInfo) GetSpeaker() *User {
+	if x != nil {
+		return x.Speaker
+	}
+	return nil
+}
 
-type ServerAction struct {
+func (x *RoomInfo) GetNominees() []*User {
+	if x != nil {
+		return x.Nominees
+	}
+	return nil
+}
+
+func (x *RoomInfo) GetChat() *ChatPermissions {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+type RoomDescription struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Action:
-	//
-	//	*ServerAction_ServerMessage
-	//	*ServerAction_Event
-	Action isServerAction_Action `protobuf_oneof:"Action"`
-}
-
-func (x *ServerAction) Reset() {
-	*x = ServerAction{}
+	RoomID        uint64 `protobuf:"varint,1,opt,name=RoomID,proto3" json:"RoomID,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	State         State  `protobuf:"varint,3,opt,name=State,proto3,enum=Mafia.Connection.State" json:"State,omitempty"`
+	PlayersCount  uint32 `protobuf:"varint,4,opt,name=PlayersCount,proto3" json:"PlayersCount,omitempty"`
+	MaxPlayers    uint32 `protobuf:"varint,5,opt,name=MaxPlayers,proto3" json:"MaxPlayers,omitempty"`
+	Private       bool   `protobuf:"varint,6,opt,name=Private,proto3" json:"Private,omitempty"`
+	InviteCode    string `protobuf:"bytes,7,opt,name=InviteCode,proto3" json:"InviteCode,omitempty"`
+	TieBreak      string `protobuf:"bytes,8,opt,name=TieBreak,proto3" json:"TieBreak,omitempty"`
+	Rules         string `protobuf:"bytes,9,opt,name=Rules,proto3" json:"Rules,omitempty"`
+	Ruleset       string `protobuf:"bytes,10,opt,name=Ruleset,proto3" json:"Ruleset,omitempty"`
+	RevealOnDeath string `protobuf:"bytes,11,opt,name=RevealOnDeath,proto3" json:"RevealOnDeath,omitempty"`
+}
+
+func (x *RoomDescription) Reset() {
+	*x = RoomDescription{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_connection_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -532,13 +746,13 @@ func (x *ServerAction) Reset() {
 	}
 }
 
-func (x *ServerAction) String() string {
+func (x *RoomDescription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerAction) ProtoMessage() {}
+func (*RoomDescription) ProtoMessage() {}
 
-func (x *ServerAction) ProtoReflect() protoreflect.Message {
+func (x *RoomDescription) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_connection_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -550,122 +764,1412 @@ func (x *ServerAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerAction.ProtoReflect.Descriptor instead.
-func (*ServerAction) Descriptor() ([]byte, []int) {
+// Deprecated: Use RoomDescription.ProtoReflect.Descriptor instead.
+func (*RoomDescription) Descriptor() ([]byte, []int) {
 	return file_protos_connection_proto_rawDescGZIP(), []int{6}
 }
 
-func (m *ServerAction) GetAction() isServerAction_Action {
-	if m != nil {
-		return m.Action
+func (x *RoomDescription) GetRoomID() uint64 {
+	if x != nil {
+		return x.RoomID
 	}
-	return nil
+	return 0
 }
 
-func (x *ServerAction) GetServerMessage() string {
-	if x, ok := x.GetAction().(*ServerAction_ServerMessage); ok {
-		return x.ServerMessage
+func (x *RoomDescription) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ServerAction) GetEvent() *RoomEvent {
-	if x, ok := x.GetAction().(*ServerAction_Event); ok {
-		return x.Event
+func (x *RoomDescription) GetState() State {
+	if x != nil {
+		return x.State
 	}
-	return nil
+	return State_NOT_STARTED
 }
 
-type isServerAction_Action interface {
-	isServerAction_Action()
+func (x *RoomDescription) GetPlayersCount() uint32 {
+	if x != nil {
+		return x.PlayersCount
+	}
+	return 0
 }
 
-type ServerAction_ServerMessage struct {
-	ServerMessage string `protobuf:"bytes,1,opt,name=ServerMessage,proto3,oneof"`
+func (x *RoomDescription) GetMaxPlayers() uint32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
 }
 
-type ServerAction_Event struct {
-	Event *RoomEvent `protobuf:"bytes,2,opt,name=Event,proto3,oneof"`
+func (x *RoomDescription) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
 }
 
-func (*ServerAction_ServerMessage) isServerAction_Action() {}
+func (x *RoomDescription) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
 
-func (*ServerAction_Event) isServerAction_Action() {}
+func (x *RoomDescription) GetTieBreak() string {
+	if x != nil {
+		return x.TieBreak
+	}
+	return ""
+}
 
-var File_protos_connection_proto protoreflect.FileDescriptor
+func (x *RoomDescription) GetRules() string {
+	if x != nil {
+		return x.Rules
+	}
+	return ""
+}
 
-var file_protos_connection_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x4d, 0x61, 0x66, 0x69, 0x61,
-	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61,
-	0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x32, 0x0a, 0x04, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x51, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65,
-	0x78, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x22, 0x76, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x04,
-	0x55, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66,
-	0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04,
-	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x05, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x08, 0x52,
-	0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12,
-	0x2d, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+func (x *RoomDescription) GetRuleset() string {
+	if x != nil {
+		return x.Ruleset
+	}
+	return ""
+}
+
+func (x *RoomDescription) GetRevealOnDeath() string {
+	if x != nil {
+		return x.RevealOnDeath
+	}
+	return ""
+}
+
+type RoomList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rooms []*RoomDescription `protobuf:"bytes,1,rep,name=Rooms,proto3" json:"Rooms,omitempty"`
+}
+
+func (x *RoomList) Reset() {
+	*x = RoomList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_connection_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomList) ProtoMessage() {}
+
+func (x *RoomList) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_connection_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomList.ProtoReflect.Descriptor instead.
+func (*RoomList) Descriptor() ([]byte, []int) {
+	return file_protos_connection_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RoomList) GetRooms() []*RoomDescription {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+type CreateRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Private       bool   `protobuf:"varint,2,opt,name=Private,proto3" json:"Private,omitempty"`
+	TieBreak      string `protobuf:"bytes,3,opt,name=TieBreak,proto3" json:"TieBreak,omitempty"`
+	Rules         string `protobuf:"bytes,4,opt,name=Rules,proto3" json:"Rules,omitempty"`
+	Ruleset       string `protobuf:"bytes,5,opt,name=Ruleset,proto3" json:"Ruleset,omitempty"`
+	RevealOnDeath string `protobuf:"bytes,6,opt,name=RevealOnDeath,proto3" json:"RevealOnDeath,omitempty"`
+}
+
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_connection_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomRequest) ProtoMessage() {}
+
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_connection_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_protos_connection_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateRoomRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *CreateRoomRequest) GetTieBreak() string {
+	if x != nil {
+		return x.TieBreak
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetRules() string {
+	if x != nil {
+		return x.Rules
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetRuleset() string {
+	if x != nil {
+		return x.Ruleset
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetRevealOnDeath() string {
+	if x != nil {
+		return x.RevealOnDeath
+	}
+	return ""
+}
+
+type JoinRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User       *User  `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	RoomID     uint64 `protobuf:"varint,2,opt,name=RoomID,proto3" json:"RoomID,omitempty"`
+	InviteCode string `protobuf:"bytes,3,opt,name=InviteCode,proto3" json:"InviteCode,omitempty"`
+	Spectate   bool   `protobuf:"varint,4,opt,name=Spectate,proto3" json:"Spectate,omitempty"`
+}
+
+func (x *JoinRoomRequest) Reset() {
+	*x = JoinRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_connection_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomRequest) ProtoMessage() {}
+
+func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_connection_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomRequest.ProtoReflect.Descriptor instead.
+func (*JoinRoomRequest) Descriptor() ([]byte, []int) {
+	return file_protos_connection_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *JoinRoomRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *JoinRoomRequest) GetRoomID() uint64 {
+	if x != nil {
+		return x.RoomID
+	}
+	return 0
+}
+
+func (x *JoinRoomRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetSpectate() bool {
+	if x != nil {
+		return x.Spectate
+	}
+	return false
+}
+
+type GameEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq    uint64               `protobuf:"varint,1,opt,name=Seq,proto3" json:"Seq,omitempty"`
+	Time   *timestamp.Timestamp `protobuf:"bytes,2,opt,name=Time,proto3" json:"Time,omitempty"`
+	Type   EventType            `protobuf:"varint,3,opt,name=Type,proto3,enum=Mafia.Connection.EventType" json:"Type,omitempty"`
+	Actor  *User                `protobuf:"bytes,4,opt,name=Actor,proto3" json:"Actor,omitempty"`
+	Target *User                `protobuf:"bytes,5,opt,name=Target,proto3" json:"Target,omitempty"`
+	Role   Role                 `protobuf:"varint,6,opt,name=Role,proto3,enum=Mafia.Connection.Role" json:"Role,omitempty"`
+	State  State                `protobuf:"varint,7,opt,name=State,proto3,enum=Mafia.Connection.State" json:"State,omitempty"`
+	Winner string               `protobuf:"bytes,8,opt,name=Winner,proto3" json:"Winner,omitempty"`
+	Bot    bool                 `protobuf:"varint,9,opt,name=Bot,proto3" json:"Bot,omitempty"`
+	Text   string               `protobuf:"bytes,10,opt,name=Text,proto3" json:"Text,omitempty"`
+}
+
+func (x *GameEvent) Reset() {
+	*x = GameEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_connection_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameEvent) ProtoMessage() {}
+
+func (x *GameEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_connection_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameEvent.ProtoReflect.Descriptor instead.
+func (*GameEvent) Descriptor() ([]byte, []int) {
+	return file_protos_connection_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GameEvent) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GameEvent) GetTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *GameEvent) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_JOIN
+}
+
+func (x *GameEvent) GetActor() *User {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *GameEvent) GetTarget() *User {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *GameEvent) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_UNKNOWN
+}
+
+func (x *GameEvent) GetState() State {
+	if x != nil {
+		return x.State
+	}
+	return State_NOT_STARTED
+}
+
+func (x *GameEvent) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *GameEvent) GetBot() bool {
+	if x != nil {
+		return x.Bot
+	}
+	return false
+}
+
+func (x *GameEvent) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type RulesetList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=Names,proto3" json:"Names,omitempty"`
+}
+
+func (x *RulesetList) Reset() {
+	*x = RulesetList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_connection_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RulesetList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RulesetList) ProtoMessage() {}
+
+func (x *RulesetList) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_connection_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RulesetList.ProtoReflect.Descriptor instead.
+func (*RulesetList) Descriptor() ([]byte, []int) {
+	return file_protos_connection_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RulesetList) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type RoomStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counts map[string]uint32 `protobuf:"bytes,1,rep,name=Counts,proto3" json:"Counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Total  uint32            `protobuf:"varint,2,opt,name=Total,proto3" json:"Total,omitempty"`
+}
+
+func (x *RoomStats) Reset() {
+	*x = RoomStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_connection_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomStats) ProtoMessage() {}
+
+func (x *RoomStats) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_connection_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomStats.ProtoReflect.Descriptor instead.
+func (*RoomStats) Descriptor() ([]byte, []int) {
+	return file_protos_connection_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RoomStats) GetCounts() map[string]uint32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *RoomStats) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ReplayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameID uint64 `protobuf:"varint,1,opt,name=GameID,proto3" json:"GameID,omitempty"`
+}
+
+func (x *ReplayRequest) Reset() {
+	*x = ReplayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_connection_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayRequest) ProtoMessage() {}
+
+func (x *ReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_connection_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayRequest.ProtoReflect.Descriptor instead.
+func (*ReplayRequest) Descriptor() ([]byte, []int) {
+	return file_protos_connection_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReplayRequest) GetGameID() uint64 {
+	if x != nil {
+		return x.GameID
+	}
+	return 0
+}
+
+type RoomEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event    *wrappers.StringValue `protobuf:"bytes,1,opt,name=Event,proto3" json:"Event,omitempty"`
+	RoomInfo *RoomInfo             `protobuf:"bytes,2,opt,name=RoomInfo,proto3" json:"RoomInfo,omitempty"`
+}
+
+func (x *RoomEvent) Reset() {
+	*x = RoomEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_connection_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomEvent) ProtoMessage() {}
+
+func (x *RoomEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_connection_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomEvent.ProtoReflect.Descriptor instead.
+func (*RoomEvent) Descriptor() ([]byte, []int) {
+	return file_protos_connection_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RoomEvent) GetEvent() *wrappers.StringValue {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *RoomEvent) GetRoomInfo() *RoomInfo {
+	if x != nil {
+		return x.RoomInfo
+	}
+	return nil
+}
+
+type LastWords struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Author *User  `protobuf:"bytes,1,opt,name=Author,proto3" json:"Author,omitempty"`
+	Text   string `protobuf:"bytes,2,opt,name=Text,proto3" json:"Text,omitempty"`
+}
+
+func (x *LastWords) Reset() {
+	*x = LastWords{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_connection_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LastWords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastWords) ProtoMessage() {}
+
+func (x *LastWords) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_connection_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LastWords.ProtoReflect.Descriptor instead.
+func (*LastWords) Descriptor() ([]byte, []int) {
+	return file_protos_connection_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LastWords) GetAuthor() *User {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *LastWords) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ReconnectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User    *User  `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	Session string `protobuf:"bytes,2,opt,name=Session,proto3" json:"Session,omitempty"`
+}
+
+func (x *ReconnectRequest) Reset() {
+	*x = ReconnectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_connection_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconnectRequest) ProtoMessage() {}
+
+func (x *ReconnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_connection_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconnectRequest.ProtoReflect.Descriptor instead.
+func (*ReconnectRequest) Descriptor() ([]byte, []int) {
+	return file_protos_connection_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReconnectRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ReconnectRequest) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+type PlayerAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Action:
+	//
+	//	*PlayerAction_Connetion
+	//	*PlayerAction_Vote
+	//	*PlayerAction_Show
+	//	*PlayerAction_Heal
+	//	*PlayerAction_Reconnect
+	//	*PlayerAction_Ready
+	//	*PlayerAction_Start
+	//	*PlayerAction_Kick
+	//	*PlayerAction_Rematch
+	//	*PlayerAction_Abstain
+	//	*PlayerAction_Retract
+	//	*PlayerAction_LastWords
+	//	*PlayerAction_Nominate
+	//	*PlayerAction_EndSpeech
+	//	*PlayerAction_Check
+	Action isPlayerAction_Action `protobuf_oneof:"Action"`
+}
+
+func (x *PlayerAction) Reset() {
+	*x = PlayerAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_connection_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerAction) ProtoMessage() {}
+
+func (x *PlayerAction) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_connection_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerAction.ProtoReflect.Descriptor instead.
+func (*PlayerAction) Descriptor() ([]byte, []int) {
+	return file_protos_connection_proto_rawDescGZIP(), []int{17}
+}
+
+func (m *PlayerAction) GetAction() isPlayerAction_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (x *PlayerAction) GetConnetion() *User {
+	if x, ok := x.GetAction().(*PlayerAction_Connetion); ok {
+		return x.Connetion
+	}
+	return nil
+}
+
+func (x *PlayerAction) GetVote() *User {
+	if x, ok := x.GetAction().(*PlayerAction_Vote); ok {
+		return x.Vote
+	}
+	return nil
+}
+
+func (x *PlayerAction) GetShow() *User {
+	if x, ok := x.GetAction().(*PlayerAction_Show); ok {
+		return x.Show
+	}
+	return nil
+}
+
+func (x *PlayerAction) GetHeal() *User {
+	if x, ok := x.GetAction().(*PlayerAction_Heal); ok {
+		return x.Heal
+	}
+	return nil
+}
+
+func (x *PlayerAction) GetReconnect() *ReconnectRequest {
+	if x, ok := x.GetAction().(*PlayerAction_Reconnect); ok {
+		return x.Reconnect
+	}
+	return nil
+}
+
+func (x *PlayerAction) GetReady() bool {
+	if x, ok := x.GetAction().(*PlayerAction_Ready); ok {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *PlayerAction) GetStart() *empty.Empty {
+	if x, ok := x.GetAction().(*PlayerAction_Start); ok {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *PlayerAction) GetKick() *User {
+	if x, ok := x.GetAction().(*PlayerAction_Kick); ok {
+		return x.Kick
+	}
+	return nil
+}
+
+func (x *PlayerAction) GetRematch() *empty.Empty {
+	if x, ok := x.GetAction().(*PlayerAction_Rematch); ok {
+		return x.Rematch
+	}
+	return nil
+}
+
+func (x *PlayerAction) GetAbstain() *empty.Empty {
+	if x, ok := x.GetAction().(*PlayerAction_Abstain); ok {
+		return x.Abstain
+	}
+	return nil
+}
+
+func (x *PlayerAction) GetRetract() *empty.Empty {
+	if x, ok := x.GetAction().(*PlayerAction_Retract); ok {
+		return x.Retract
+	}
+	return nil
+}
+
+func (x *PlayerAction) GetLastWords() string {
+	if x, ok := x.GetAction().(*PlayerAction_LastWords); ok {
+		return x.LastWords
+	}
+	return ""
+}
+
+func (x *PlayerAction) GetNominate() *User {
+	if x, ok := x.GetAction().(*PlayerAction_Nominate); ok {
+		return x.Nominate
+	}
+	return nil
+}
+
+func (x *PlayerAction) GetEndSpeech() *empty.Empty {
+	if x, ok := x.GetAction().(*PlayerAction_EndSpeech); ok {
+		return x.EndSpeech
+	}
+	return nil
+}
+
+func (x *PlayerAction) GetCheck() *User {
+	if x, ok := x.GetAction().(*PlayerAction_Check); ok {
+		return x.Check
+	}
+	return nil
+}
+
+type isPlayerAction_Action interface {
+	isPlayerAction_Action()
+}
+
+type PlayerAction_Connetion struct {
+	Connetion *User `protobuf:"bytes,1,opt,name=Connetion,proto3,oneof"`
+}
+
+type PlayerAction_Vote struct {
+	Vote *User `protobuf:"bytes,2,opt,name=Vote,proto3,oneof"`
+}
+
+type PlayerAction_Show struct {
+	Show *User `protobuf:"bytes,3,opt,name=Show,proto3,oneof"`
+}
+
+type PlayerAction_Heal struct {
+	Heal *User `protobuf:"bytes,4,opt,name=Heal,proto3,oneof"`
+}
+
+type PlayerAction_Reconnect struct {
+	Reconnect *ReconnectRequest `protobuf:"bytes,5,opt,name=Reconnect,proto3,oneof"`
+}
+
+type PlayerAction_Ready struct {
+	Ready bool `protobuf:"varint,6,opt,name=Ready,proto3,oneof"`
+}
+
+type PlayerAction_Start struct {
+	Start *empty.Empty `protobuf:"bytes,7,opt,name=Start,proto3,oneof"`
+}
+
+type PlayerAction_Kick struct {
+	Kick *User `protobuf:"bytes,8,opt,name=Kick,proto3,oneof"`
+}
+
+type PlayerAction_Rematch struct {
+	Rematch *empty.Empty `protobuf:"bytes,9,opt,name=Rematch,proto3,oneof"`
+}
+
+type PlayerAction_Abstain struct {
+	Abstain *empty.Empty `protobuf:"bytes,10,opt,name=Abstain,proto3,oneof"`
+}
+
+type PlayerAction_Retract struct {
+	Retract *empty.Empty `protobuf:"bytes,11,opt,name=Retract,proto3,oneof"`
+}
+
+type PlayerAction_LastWords struct {
+	LastWords string `protobuf:"bytes,12,opt,name=LastWords,proto3,oneof"`
+}
+
+type PlayerAction_Nominate struct {
+	Nominate *User `protobuf:"bytes,13,opt,name=Nominate,proto3,oneof"`
+}
+
+type PlayerAction_EndSpeech struct {
+	EndSpeech *empty.Empty `protobuf:"bytes,14,opt,name=EndSpeech,proto3,oneof"`
+}
+
+type PlayerAction_Check struct {
+	Check *User `protobuf:"bytes,15,opt,name=Check,proto3,oneof"`
+}
+
+func (*PlayerAction_Connetion) isPlayerAction_Action() {}
+
+func (*PlayerAction_Vote) isPlayerAction_Action() {}
+
+func (*PlayerAction_Show) isPlayerAction_Action() {}
+
+func (*PlayerAction_Heal) isPlayerAction_Action() {}
+
+func (*PlayerAction_Reconnect) isPlayerAction_Action() {}
+
+func (*PlayerAction_Ready) isPlayerAction_Action() {}
+
+func (*PlayerAction_Start) isPlayerAction_Action() {}
+
+func (*PlayerAction_Kick) isPlayerAction_Action() {}
+
+func (*PlayerAction_Rematch) isPlayerAction_Action() {}
+
+func (*PlayerAction_Abstain) isPlayerAction_Action() {}
+
+func (*PlayerAction_Retract) isPlayerAction_Action() {}
+
+func (*PlayerAction_LastWords) isPlayerAction_Action() {}
+
+func (*PlayerAction_Nominate) isPlayerAction_Action() {}
+
+func (*PlayerAction_EndSpeech) isPlayerAction_Action() {}
+
+func (*PlayerAction_Check) isPlayerAction_Action() {}
+
+type ServerAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Action:
+	//
+	//	*ServerAction_ServerMessage
+	//	*ServerAction_Event
+	//	*ServerAction_Session
+	//	*ServerAction_LastWords
+	Action isServerAction_Action `protobuf_oneof:"Action"`
+}
+
+func (x *ServerAction) Reset() {
+	*x = ServerAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_connection_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerAction) ProtoMessage() {}
+
+func (x *ServerAction) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_connection_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerAction.ProtoReflect.Descriptor instead.
+func (*ServerAction) Descriptor() ([]byte, []int) {
+	return file_protos_connection_proto_rawDescGZIP(), []int{18}
+}
+
+func (m *ServerAction) GetAction() isServerAction_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (x *ServerAction) GetServerMessage() string {
+	if x, ok := x.GetAction().(*ServerAction_ServerMessage); ok {
+		return x.ServerMessage
+	}
+	return ""
+}
+
+func (x *ServerAction) GetEvent() *RoomEvent {
+	if x, ok := x.GetAction().(*ServerAction_Event); ok {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *ServerAction) GetSession() string {
+	if x, ok := x.GetAction().(*ServerAction_Session); ok {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *ServerAction) GetLastWords() *LastWords {
+	if x, ok := x.GetAction().(*ServerAction_LastWords); ok {
+		return x.LastWords
+	}
+	return nil
+}
+
+type isServerAction_Action interface {
+	isServerAction_Action()
+}
+
+type ServerAction_ServerMessage struct {
+	ServerMessage string `protobuf:"bytes,1,opt,name=ServerMessage,proto3,oneof"`
+}
+
+type ServerAction_Event struct {
+	Event *RoomEvent `protobuf:"bytes,2,opt,name=Event,proto3,oneof"`
+}
+
+type ServerAction_Session struct {
+	Session string `protobuf:"bytes,3,opt,name=Session,proto3,oneof"`
+}
+
+type ServerAction_LastWords struct {
+	LastWords *LastWords `protobuf:"bytes,4,opt,name=LastWords,proto3,oneof"`
+}
+
+func (*ServerAction_ServerMessage) isServerAction_Action() {}
+
+func (*ServerAction_Event) isServerAction_Action() {}
+
+func (*ServerAction_Session) isServerAction_Action() {}
+
+func (*ServerAction_LastWords) isServerAction_Action() {}
+
+var File_protos_connection_proto protoreflect.FileDescriptor
+
+var file_protos_connection_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x4d, 0x61, 0x66, 0x69, 0x61,
+	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x32, 0x0a, 0x04, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x51, 0x0a,
+	0x0b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x54, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74,
+	0x12, 0x2e, 0x0a, 0x06, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x22, 0xcc, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x04, 0x55,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69,
+	0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x42, 0x6f, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x42, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x52,
+	0x65, 0x61, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x52, 0x65, 0x61, 0x64,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x65, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x53, 0x65, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x46, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x51, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x44, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x03, 0x44, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x4e, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x68,
+	0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x47, 0x68, 0x6f, 0x73,
+	0x74, 0x73, 0x22, 0x7e, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x56, 0x6f,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69,
+	0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x05, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61,
+	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x62, 0x73, 0x74,
+	0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x41, 0x62, 0x73, 0x74, 0x61,
+	0x69, 0x6e, 0x22, 0xc8, 0x05, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x52, 0x07, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x61,
+	0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x4d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x44, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0a,
+	0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x48, 0x6f,
+	0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61,
+	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x69, 0x6e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x4d, 0x69, 0x6e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x52, 0x75, 0x6e, 0x6f, 0x66, 0x66,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06,
+	0x52, 0x75, 0x6e, 0x6f, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x05, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x56,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x10, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x64,
+	0x73, 0x53, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
 	0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x32,
-	0x0a, 0x07, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x73, 0x22, 0x77, 0x0a, 0x09, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x32, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xac, 0x01, 0x0a, 0x0c,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x09,
-	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x09, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x10, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x64,
+	0x73, 0x53, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x44, 0x61, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x44, 0x61, 0x79,
+	0x12, 0x30, 0x0a, 0x07, 0x53, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07, 0x53, 0x70, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x12, 0x32, 0x0a, 0x08, 0x4e, 0x6f, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x73, 0x18, 0x0f,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x08, 0x4e, 0x6f,
+	0x6d, 0x69, 0x6e, 0x65, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x43, 0x68, 0x61, 0x74, 0x22, 0xdc, 0x02,
+	0x0a, 0x0f, 0x52, 0x6f, 0x6f, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a,
+	0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x4d,
+	0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x4d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x69,
+	0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x69,
+	0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c,
+	0x4f, 0x6e, 0x44, 0x65, 0x61, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x52,
+	0x65, 0x76, 0x65, 0x61, 0x6c, 0x4f, 0x6e, 0x44, 0x65, 0x61, 0x74, 0x68, 0x22, 0x43, 0x0a, 0x08,
+	0x52, 0x6f, 0x6f, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x52, 0x6f, 0x6f, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x52, 0x6f, 0x6f, 0x6d,
+	0x73, 0x22, 0xb3, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x69, 0x65, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x69, 0x65, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x4f, 0x6e, 0x44, 0x65, 0x61,
+	0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c,
+	0x4f, 0x6e, 0x44, 0x65, 0x61, 0x74, 0x68, 0x22, 0x91, 0x01, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e,
+	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x55,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69,
+	0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12,
+	0x1e, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x22, 0xf5, 0x02, 0x0a, 0x09,
+	0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x65, 0x71,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x53, 0x65, 0x71, 0x12, 0x2e, 0x0a, 0x04, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x4d, 0x61, 0x66, 0x69,
+	0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x05,
+	0x41, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61,
+	0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x06, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66,
+	0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x52, 0x6f,
+	0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61,
+	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a,
+	0x03, 0x42, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x42, 0x6f, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54,
+	0x65, 0x78, 0x74, 0x22, 0x23, 0x0a, 0x0b, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x09, 0x52, 0x6f, 0x6f,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0x39, 0x0a,
+	0x0b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x27, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x61, 0x6d,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49,
+	0x44, 0x22, 0x77, 0x0a, 0x09, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x32,
+	0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x4f, 0x0a, 0x09, 0x4c, 0x61,
+	0x73, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x06, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x22, 0x58, 0x0a, 0x10, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2a, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xee, 0x05, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69,
+	0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x48, 0x00, 0x52, 0x09, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c,
+	0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d,
+	0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x04,
+	0x53, 0x68, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66,
+	0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x48, 0x00, 0x52, 0x04, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x2c, 0x0a, 0x04, 0x48, 0x65,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61,
+	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x04, 0x48, 0x65, 0x61, 0x6c, 0x12, 0x42, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4d, 0x61,
+	0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x09, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x05,
+	0x52, 0x65, 0x61, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x05, 0x52,
+	0x65, 0x61, 0x64, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x4b, 0x69, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x04, 0x56, 0x6f,
-	0x74, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x53, 0x68, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x04, 0x4b, 0x69,
+	0x63, 0x6b, 0x12, 0x32, 0x0a, 0x07, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x07, 0x52,
+	0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x32, 0x0a, 0x07, 0x41, 0x62, 0x73, 0x74, 0x61, 0x69,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48,
+	0x00, 0x52, 0x07, 0x41, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x52, 0x65,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x07, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1e,
+	0x0a, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x34,
+	0x0a, 0x08, 0x4e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x16, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x04, 0x53, 0x68, 0x6f, 0x77,
-	0x42, 0x08, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x75, 0x0a, 0x0c, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0d, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
-	0x52, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2a, 0x39, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x41, 0x46, 0x49, 0x41, 0x10,
-	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x48, 0x45, 0x52, 0x49, 0x46, 0x46, 0x10, 0x02, 0x12, 0x0c,
-	0x0a, 0x08, 0x43, 0x49, 0x56, 0x49, 0x4c, 0x49, 0x41, 0x4e, 0x10, 0x03, 0x2a, 0x35, 0x0a, 0x05,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41,
-	0x52, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x49, 0x47, 0x48, 0x54, 0x10,
-	0x01, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x41, 0x59, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x4e,
-	0x44, 0x10, 0x03, 0x32, 0x61, 0x0a, 0x0c, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65,
-	0x12, 0x1e, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x1a, 0x1e, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x1a, 0x5a, 0x18, 0x6a, 0x70, 0x65, 0x70, 0x70, 0x65,
-	0x72, 0x2f, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x4e, 0x6f, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x45, 0x6e, 0x64, 0x53, 0x70, 0x65, 0x65, 0x63,
+	0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48,
+	0x00, 0x52, 0x09, 0x45, 0x6e, 0x64, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x12, 0x2e, 0x0a, 0x05,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61,
+	0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x08, 0x0a, 0x06,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xce, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x33, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x3b, 0x0a, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x73,
+	0x48, 0x00, 0x52, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x08, 0x0a,
+	0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x5a, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x4d, 0x41, 0x46, 0x49, 0x41, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x48, 0x45, 0x52, 0x49,
+	0x46, 0x46, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x49, 0x56, 0x49, 0x4c, 0x49, 0x41, 0x4e,
+	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x4f, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x0a,
+	0x0a, 0x06, 0x4d, 0x41, 0x4e, 0x49, 0x41, 0x43, 0x10, 0x05, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x4f,
+	0x4e, 0x10, 0x06, 0x2a, 0x35, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b,
+	0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a,
+	0x05, 0x4e, 0x49, 0x47, 0x48, 0x54, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x41, 0x59, 0x10,
+	0x02, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x4e, 0x44, 0x10, 0x03, 0x2a, 0xcb, 0x01, 0x0a, 0x09, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x4f, 0x49, 0x4e,
+	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a,
+	0x0d, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x08, 0x0a, 0x04, 0x56, 0x4f, 0x54, 0x45, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x49,
+	0x4c, 0x4c, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x45, 0x41, 0x4c, 0x10, 0x05, 0x12, 0x09,
+	0x0a, 0x05, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x48, 0x4f,
+	0x57, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x4f, 0x54, 0x45, 0x44, 0x5f, 0x4f, 0x55, 0x54,
+	0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x10, 0x09, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x10, 0x0a,
+	0x12, 0x0b, 0x0a, 0x07, 0x41, 0x42, 0x53, 0x54, 0x41, 0x49, 0x4e, 0x10, 0x0b, 0x12, 0x0b, 0x0a,
+	0x07, 0x52, 0x45, 0x54, 0x52, 0x41, 0x43, 0x54, 0x10, 0x0c, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x41,
+	0x53, 0x54, 0x5f, 0x57, 0x4f, 0x52, 0x44, 0x53, 0x10, 0x0d, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f,
+	0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x10, 0x0e, 0x32, 0xb0, 0x04, 0x0a, 0x0c, 0x4d, 0x61, 0x66,
+	0x69, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x09, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1e, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x09,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x1a, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12,
+	0x56, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x23, 0x2e,
+	0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x52,
+	0x6f, 0x6f, 0x6d, 0x12, 0x21, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0a, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x2e, 0x4d, 0x61, 0x66, 0x69,
+	0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x4d, 0x61, 0x66,
+	0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x4d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65,
+	0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x4d, 0x61, 0x66,
+	0x69, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x42, 0x1a, 0x5a, 0x18, 0x6a,
+	0x70, 0x65, 0x70, 0x70, 0x65, 0x72, 0x2f, 0x6d, 0x61, 0x66, 0x69, 0x61, 0x2e, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -680,39 +2184,101 @@ func file_protos_connection_proto_rawDescGZIP() []byte {
 	return file_protos_connection_proto_rawDescData
 }
 
-var file_protos_connection_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_protos_connection_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_protos_connection_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_protos_connection_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_protos_connection_proto_goTypes = []interface{}{
 	(Role)(0),                    // 0: Mafia.Connection.Role
 	(State)(0),                   // 1: Mafia.Connection.State
-	(*User)(nil),                 // 2: Mafia.Connection.User
-	(*ChatMessage)(nil),          // 3: Mafia.Connection.ChatMessage
-	(*Player)(nil),               // 4: Mafia.Connection.Player
-	(*RoomInfo)(nil),             // 5: Mafia.Connection.RoomInfo
-	(*RoomEvent)(nil),            // 6: Mafia.Connection.RoomEvent
-	(*PlayerAction)(nil),         // 7: Mafia.Connection.PlayerAction
-	(*ServerAction)(nil),         // 8: Mafia.Connection.ServerAction
-	(*wrappers.StringValue)(nil), // 9: google.protobuf.StringValue
+	(EventType)(0),               // 2: Mafia.Connection.EventType
+	(*User)(nil),                 // 3: Mafia.Connection.User
+	(*ChatMessage)(nil),          // 4: Mafia.Connection.ChatMessage
+	(*Player)(nil),               // 5: Mafia.Connection.Player
+	(*ChatPermissions)(nil),      // 6: Mafia.Connection.ChatPermissions
+	(*Vote)(nil),                 // 7: Mafia.Connection.Vote
+	(*RoomInfo)(nil),             // 8: Mafia.Connection.RoomInfo
+	(*RoomDescription)(nil),      // 9: Mafia.Connection.RoomDescription
+	(*RoomList)(nil),             // 10: Mafia.Connection.RoomList
+	(*CreateRoomRequest)(nil),    // 11: Mafia.Connection.CreateRoomRequest
+	(*JoinRoomRequest)(nil),      // 12: Mafia.Connection.JoinRoomRequest
+	(*GameEvent)(nil),            // 13: Mafia.Connection.GameEvent
+	(*RulesetList)(nil),          // 14: Mafia.Connection.RulesetList
+	(*RoomStats)(nil),            // 15: Mafia.Connection.RoomStats
+	(*ReplayRequest)(nil),        // 16: Mafia.Connection.ReplayRequest
+	(*RoomEvent)(nil),            // 17: Mafia.Connection.RoomEvent
+	(*LastWords)(nil),            // 18: Mafia.Connection.LastWords
+	(*ReconnectRequest)(nil),     // 19: Mafia.Connection.ReconnectRequest
+	(*PlayerAction)(nil),         // 20: Mafia.Connection.PlayerAction
+	(*ServerAction)(nil),         // 21: Mafia.Connection.ServerAction
+	nil,                          // 22: Mafia.Connection.RoomStats.CountsEntry
+	(*timestamp.Timestamp)(nil),  // 23: google.protobuf.Timestamp
+	(*wrappers.StringValue)(nil), // 24: google.protobuf.StringValue
+	(*empty.Empty)(nil),          // 25: google.protobuf.Empty
 }
 var file_protos_connection_proto_depIdxs = []int32{
-	2,  // 0: Mafia.Connection.ChatMessage.Author:type_name -> Mafia.Connection.User
-	2,  // 1: Mafia.Connection.Player.User:type_name -> Mafia.Connection.User
+	3,  // 0: Mafia.Connection.ChatMessage.Author:type_name -> Mafia.Connection.User
+	3,  // 1: Mafia.Connection.Player.User:type_name -> Mafia.Connection.User
 	0,  // 2: Mafia.Connection.Player.Role:type_name -> Mafia.Connection.Role
-	1,  // 3: Mafia.Connection.RoomInfo.State:type_name -> Mafia.Connection.State
-	4,  // 4: Mafia.Connection.RoomInfo.Players:type_name -> Mafia.Connection.Player
-	9,  // 5: Mafia.Connection.RoomEvent.Event:type_name -> google.protobuf.StringValue
-	5,  // 6: Mafia.Connection.RoomEvent.RoomInfo:type_name -> Mafia.Connection.RoomInfo
-	2,  // 7: Mafia.Connection.PlayerAction.Connetion:type_name -> Mafia.Connection.User
-	2,  // 8: Mafia.Connection.PlayerAction.Vote:type_name -> Mafia.Connection.User
-	2,  // 9: Mafia.Connection.PlayerAction.Show:type_name -> Mafia.Connection.User
-	6,  // 10: Mafia.Connection.ServerAction.Event:type_name -> Mafia.Connection.RoomEvent
-	7,  // 11: Mafia.Connection.MafiaService.RouteGame:input_type -> Mafia.Connection.PlayerAction
-	8,  // 12: Mafia.Connection.MafiaService.RouteGame:output_type -> Mafia.Connection.ServerAction
-	12, // [12:13] is the sub-list for method output_type
-	11, // [11:12] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	3,  // 3: Mafia.Connection.Vote.Voter:type_name -> Mafia.Connection.User
+	3,  // 4: Mafia.Connection.Vote.Target:type_name -> Mafia.Connection.User
+	1,  // 5: Mafia.Connection.RoomInfo.State:type_name -> Mafia.Connection.State
+	5,  // 6: Mafia.Connection.RoomInfo.Players:type_name -> Mafia.Connection.Player
+	23, // 7: Mafia.Connection.RoomInfo.Deadline:type_name -> google.protobuf.Timestamp
+	3,  // 8: Mafia.Connection.RoomInfo.Spectators:type_name -> Mafia.Connection.User
+	3,  // 9: Mafia.Connection.RoomInfo.Host:type_name -> Mafia.Connection.User
+	3,  // 10: Mafia.Connection.RoomInfo.Runoff:type_name -> Mafia.Connection.User
+	7,  // 11: Mafia.Connection.RoomInfo.Votes:type_name -> Mafia.Connection.Vote
+	3,  // 12: Mafia.Connection.RoomInfo.LastWordsSpeaker:type_name -> Mafia.Connection.User
+	3,  // 13: Mafia.Connection.RoomInfo.Speaker:type_name -> Mafia.Connection.User
+	3,  // 14: Mafia.Connection.RoomInfo.Nominees:type_name -> Mafia.Connection.User
+	6,  // 15: Mafia.Connection.RoomInfo.Chat:type_name -> Mafia.Connection.ChatPermissions
+	1,  // 16: Mafia.Connection.RoomDescription.State:type_name -> Mafia.Connection.State
+	9,  // 17: Mafia.Connection.RoomList.Rooms:type_name -> Mafia.Connection.RoomDescription
+	3,  // 18: Mafia.Connection.JoinRoomRequest.User:type_name -> Mafia.Connection.User
+	23, // 19: Mafia.Connection.GameEvent.Time:type_name -> google.protobuf.Timestamp
+	2,  // 20: Mafia.Connection.GameEvent.Type:type_name -> Mafia.Connection.EventType
+	3,  // 21: Mafia.Connection.GameEvent.Actor:type_name -> Mafia.Connection.User
+	3,  // 22: Mafia.Connection.GameEvent.Target:type_name -> Mafia.Connection.User
+	0,  // 23: Mafia.Connection.GameEvent.Role:type_name -> Mafia.Connection.Role
+	1,  // 24: Mafia.Connection.GameEvent.State:type_name -> Mafia.Connection.State
+	22, // 25: Mafia.Connection.RoomStats.Counts:type_name -> Mafia.Connection.RoomStats.CountsEntry
+	24, // 26: Mafia.Connection.RoomEvent.Event:type_name -> google.protobuf.StringValue
+	8,  // 27: Mafia.Connection.RoomEvent.RoomInfo:type_name -> Mafia.Connection.RoomInfo
+	3,  // 28: Mafia.Connection.LastWords.Author:type_name -> Mafia.Connection.User
+	3,  // 29: Mafia.Connection.ReconnectRequest.User:type_name -> Mafia.Connection.User
+	3,  // 30: Mafia.Connection.PlayerAction.Connetion:type_name -> Mafia.Connection.User
+	3,  // 31: Mafia.Connection.PlayerAction.Vote:type_name -> Mafia.Connection.User
+	3,  // 32: Mafia.Connection.PlayerAction.Show:type_name -> Mafia.Connection.User
+	3,  // 33: Mafia.Connection.PlayerAction.Heal:type_name -> Mafia.Connection.User
+	19, // 34: Mafia.Connection.PlayerAction.Reconnect:type_name -> Mafia.Connection.ReconnectRequest
+	25, // 35: Mafia.Connection.PlayerAction.Start:type_name -> google.protobuf.Empty
+	3,  // 36: Mafia.Connection.PlayerAction.Kick:type_name -> Mafia.Connection.User
+	25, // 37: Mafia.Connection.PlayerAction.Rematch:type_name -> google.protobuf.Empty
+	25, // 38: Mafia.Connection.PlayerAction.Abstain:type_name -> google.protobuf.Empty
+	25, // 39: Mafia.Connection.PlayerAction.Retract:type_name -> google.protobuf.Empty
+	3,  // 40: Mafia.Connection.PlayerAction.Nominate:type_name -> Mafia.Connection.User
+	25, // 41: Mafia.Connection.PlayerAction.EndSpeech:type_name -> google.protobuf.Empty
+	3,  // 42: Mafia.Connection.PlayerAction.Check:type_name -> Mafia.Connection.User
+	17, // 43: Mafia.Connection.ServerAction.Event:type_name -> Mafia.Connection.RoomEvent
+	18, // 44: Mafia.Connection.ServerAction.LastWords:type_name -> Mafia.Connection.LastWords
+	20, // 45: Mafia.Connection.MafiaService.RouteGame:input_type -> Mafia.Connection.PlayerAction
+	25, // 46: Mafia.Connection.MafiaService.ListRooms:input_type -> google.protobuf.Empty
+	11, // 47: Mafia.Connection.MafiaService.CreateRoom:input_type -> Mafia.Connection.CreateRoomRequest
+	12, // 48: Mafia.Connection.MafiaService.JoinRoom:input_type -> Mafia.Connection.JoinRoomRequest
+	16, // 49: Mafia.Connection.MafiaService.ReplayGame:input_type -> Mafia.Connection.ReplayRequest
+	25, // 50: Mafia.Connection.MafiaService.GetRoomStats:input_type -> google.protobuf.Empty
+	25, // 51: Mafia.Connection.MafiaService.ListRulesets:input_type -> google.protobuf.Empty
+	21, // 52: Mafia.Connection.MafiaService.RouteGame:output_type -> Mafia.Connection.ServerAction
+	10, // 53: Mafia.Connection.MafiaService.ListRooms:output_type -> Mafia.Connection.RoomList
+	9,  // 54: Mafia.Connection.MafiaService.CreateRoom:output_type -> Mafia.Connection.RoomDescription
+	9,  // 55: Mafia.Connection.MafiaService.JoinRoom:output_type -> Mafia.Connection.RoomDescription
+	13, // 56: Mafia.Connection.MafiaService.ReplayGame:output_type -> Mafia.Connection.GameEvent
+	15, // 57: Mafia.Connection.MafiaService.GetRoomStats:output_type -> Mafia.Connection.RoomStats
+	14, // 58: Mafia.Connection.MafiaService.ListRulesets:output_type -> Mafia.Connection.RulesetList
+	52, // [52:59] is the sub-list for method output_type
+	45, // [45:52] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_protos_connection_proto_init() }
@@ -758,7 +2324,7 @@ func file_protos_connection_proto_init() {
 			}
 		}
 		file_protos_connection_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoomInfo); i {
+			switch v := v.(*ChatPermissions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -770,7 +2336,7 @@ func file_protos_connection_proto_init() {
 			}
 		}
 		file_protos_connection_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoomEvent); i {
+			switch v := v.(*Vote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -782,7 +2348,7 @@ func file_protos_connection_proto_init() {
 			}
 		}
 		file_protos_connection_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerAction); i {
+			switch v := v.(*RoomInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -794,6 +2360,150 @@ func file_protos_connection_proto_init() {
 			}
 		}
 		file_protos_connection_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_connection_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_connection_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_connection_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_connection_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_connection_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RulesetList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_connection_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_connection_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_connection_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_connection_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LastWords); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_connection_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconnectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_connection_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_connection_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServerAction); i {
 			case 0:
 				return &v.state
@@ -806,22 +2516,36 @@ func file_protos_connection_proto_init() {
 			}
 		}
 	}
-	file_protos_connection_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_protos_connection_proto_msgTypes[17].OneofWrappers = []interface{}{
 		(*PlayerAction_Connetion)(nil),
 		(*PlayerAction_Vote)(nil),
 		(*PlayerAction_Show)(nil),
+		(*PlayerAction_Heal)(nil),
+		(*PlayerAction_Reconnect)(nil),
+		(*PlayerAction_Ready)(nil),
+		(*PlayerAction_Start)(nil),
+		(*PlayerAction_Kick)(nil),
+		(*PlayerAction_Rematch)(nil),
+		(*PlayerAction_Abstain)(nil),
+		(*PlayerAction_Retract)(nil),
+		(*PlayerAction_LastWords)(nil),
+		(*PlayerAction_Nominate)(nil),
+		(*PlayerAction_EndSpeech)(nil),
+		(*PlayerAction_Check)(nil),
 	}
-	file_protos_connection_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_protos_connection_proto_msgTypes[18].OneofWrappers = []interface{}{
 		(*ServerAction_ServerMessage)(nil),
 		(*ServerAction_Event)(nil),
+		(*ServerAction_Session)(nil),
+		(*ServerAction_LastWords)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_connection_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   7,
+			NumEnums:      3,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
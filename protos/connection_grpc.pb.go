@@ -8,6 +8,7 @@ package mafia_connection
 
 import (
 	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,7 +20,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	MafiaService_RouteGame_FullMethodName = "/Mafia.Connection.MafiaService/RouteGame"
+	MafiaService_RouteGame_FullMethodName    = "/Mafia.Connection.MafiaService/RouteGame"
+	MafiaService_ListRooms_FullMethodName    = "/Mafia.Connection.MafiaService/ListRooms"
+	MafiaService_CreateRoom_FullMethodName   = "/Mafia.Connection.MafiaService/CreateRoom"
+	MafiaService_JoinRoom_FullMethodName     = "/Mafia.Connection.MafiaService/JoinRoom"
+	MafiaService_ReplayGame_FullMethodName   = "/Mafia.Connection.MafiaService/ReplayGame"
+	MafiaService_GetRoomStats_FullMethodName = "/Mafia.Connection.MafiaService/GetRoomStats"
+	MafiaService_ListRulesets_FullMethodName = "/Mafia.Connection.MafiaService/ListRulesets"
 )
 
 // MafiaServiceClient is the client API for MafiaService service.
@@ -27,6 +34,12 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MafiaServiceClient interface {
 	RouteGame(ctx context.Context, opts ...grpc.CallOption) (MafiaService_RouteGameClient, error)
+	ListRooms(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RoomList, error)
+	CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*RoomDescription, error)
+	JoinRoom(ctx context.Context, in *JoinRoomRequest, opts ...grpc.CallOption) (*RoomDescription, error)
+	ReplayGame(ctx context.Context, in *ReplayRequest, opts ...grpc.CallOption) (MafiaService_ReplayGameClient, error)
+	GetRoomStats(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RoomStats, error)
+	ListRulesets(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RulesetList, error)
 }
 
 type mafiaServiceClient struct {
@@ -68,11 +81,94 @@ func (x *mafiaServiceRouteGameClient) Recv() (*ServerAction, error) {
 	return m, nil
 }
 
+func (c *mafiaServiceClient) ListRooms(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RoomList, error) {
+	out := new(RoomList)
+	err := c.cc.Invoke(ctx, MafiaService_ListRooms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaServiceClient) CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*RoomDescription, error) {
+	out := new(RoomDescription)
+	err := c.cc.Invoke(ctx, MafiaService_CreateRoom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaServiceClient) JoinRoom(ctx context.Context, in *JoinRoomRequest, opts ...grpc.CallOption) (*RoomDescription, error) {
+	out := new(RoomDescription)
+	err := c.cc.Invoke(ctx, MafiaService_JoinRoom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaServiceClient) ReplayGame(ctx context.Context, in *ReplayRequest, opts ...grpc.CallOption) (MafiaService_ReplayGameClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MafiaService_ServiceDesc.Streams[1], MafiaService_ReplayGame_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mafiaServiceReplayGameClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MafiaService_ReplayGameClient interface {
+	Recv() (*GameEvent, error)
+	grpc.ClientStream
+}
+
+type mafiaServiceReplayGameClient struct {
+	grpc.ClientStream
+}
+
+func (x *mafiaServiceReplayGameClient) Recv() (*GameEvent, error) {
+	m := new(GameEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mafiaServiceClient) GetRoomStats(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RoomStats, error) {
+	out := new(RoomStats)
+	err := c.cc.Invoke(ctx, MafiaService_GetRoomStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mafiaServiceClient) ListRulesets(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RulesetList, error) {
+	out := new(RulesetList)
+	err := c.cc.Invoke(ctx, MafiaService_ListRulesets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MafiaServiceServer is the server API for MafiaService service.
 // All implementations must embed UnimplementedMafiaServiceServer
 // for forward compatibility
 type MafiaServiceServer interface {
 	RouteGame(MafiaService_RouteGameServer) error
+	ListRooms(context.Context, *empty.Empty) (*RoomList, error)
+	CreateRoom(context.Context, *CreateRoomRequest) (*RoomDescription, error)
+	JoinRoom(context.Context, *JoinRoomRequest) (*RoomDescription, error)
+	ReplayGame(*ReplayRequest, MafiaService_ReplayGameServer) error
+	GetRoomStats(context.Context, *empty.Empty) (*RoomStats, error)
+	ListRulesets(context.Context, *empty.Empty) (*RulesetList, error)
 	mustEmbedUnimplementedMafiaServiceServer()
 }
 
@@ -83,6 +179,24 @@ type UnimplementedMafiaServiceServer struct {
 func (UnimplementedMafiaServiceServer) RouteGame(MafiaService_RouteGameServer) error {
 	return status.Errorf(codes.Unimplemented, "method RouteGame not implemented")
 }
+func (UnimplementedMafiaServiceServer) ListRooms(context.Context, *empty.Empty) (*RoomList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRooms not implemented")
+}
+func (UnimplementedMafiaServiceServer) CreateRoom(context.Context, *CreateRoomRequest) (*RoomDescription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoom not implemented")
+}
+func (UnimplementedMafiaServiceServer) JoinRoom(context.Context, *JoinRoomRequest) (*RoomDescription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinRoom not implemented")
+}
+func (UnimplementedMafiaServiceServer) ReplayGame(*ReplayRequest, MafiaService_ReplayGameServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReplayGame not implemented")
+}
+func (UnimplementedMafiaServiceServer) GetRoomStats(context.Context, *empty.Empty) (*RoomStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoomStats not implemented")
+}
+func (UnimplementedMafiaServiceServer) ListRulesets(context.Context, *empty.Empty) (*RulesetList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRulesets not implemented")
+}
 func (UnimplementedMafiaServiceServer) mustEmbedUnimplementedMafiaServiceServer() {}
 
 // UnsafeMafiaServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -122,13 +236,145 @@ func (x *mafiaServiceRouteGameServer) Recv() (*PlayerAction, error) {
 	return m, nil
 }
 
+func _MafiaService_ListRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServiceServer).ListRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MafiaService_ListRooms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServiceServer).ListRooms(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MafiaService_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServiceServer).CreateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MafiaService_CreateRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServiceServer).CreateRoom(ctx, req.(*CreateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MafiaService_JoinRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServiceServer).JoinRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MafiaService_JoinRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServiceServer).JoinRoom(ctx, req.(*JoinRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MafiaService_ReplayGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReplayRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MafiaServiceServer).ReplayGame(m, &mafiaServiceReplayGameServer{stream})
+}
+
+type MafiaService_ReplayGameServer interface {
+	Send(*GameEvent) error
+	grpc.ServerStream
+}
+
+type mafiaServiceReplayGameServer struct {
+	grpc.ServerStream
+}
+
+func (x *mafiaServiceReplayGameServer) Send(m *GameEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MafiaService_GetRoomStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServiceServer).GetRoomStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MafiaService_GetRoomStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServiceServer).GetRoomStats(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MafiaService_ListRulesets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MafiaServiceServer).ListRulesets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MafiaService_ListRulesets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MafiaServiceServer).ListRulesets(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MafiaService_ServiceDesc is the grpc.ServiceDesc for MafiaService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var MafiaService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "Mafia.Connection.MafiaService",
 	HandlerType: (*MafiaServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRooms",
+			Handler:    _MafiaService_ListRooms_Handler,
+		},
+		{
+			MethodName: "CreateRoom",
+			Handler:    _MafiaService_CreateRoom_Handler,
+		},
+		{
+			MethodName: "JoinRoom",
+			Handler:    _MafiaService_JoinRoom_Handler,
+		},
+		{
+			MethodName: "GetRoomStats",
+			Handler:    _MafiaService_GetRoomStats_Handler,
+		},
+		{
+			MethodName: "ListRulesets",
+			Handler:    _MafiaService_ListRulesets_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "RouteGame",
@@ -136,6 +382,11 @@ var MafiaService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ReplayGame",
+			Handler:       _MafiaService_ReplayGame_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "protos/connection.proto",
 }
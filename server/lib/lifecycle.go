@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"go.uber.org/zap"
+
+	game "mafia/game"
+	mafia_connection "mafia/protos"
+)
+
+type roomLifecycle struct {
+	finishedLinger time.Duration
+	emptyLinger    time.Duration
+	finishedSince  map[uint64]time.Time
+	emptySince     map[uint64]time.Time
+}
+
+func newRoomLifecycle(finishedLinger time.Duration, emptyLinger time.Duration) *roomLifecycle {
+	return &roomLifecycle{
+		finishedLinger: finishedLinger,
+		emptyLinger:    emptyLinger,
+		finishedSince:  make(map[uint64]time.Time),
+		emptySince:     make(map[uint64]time.Time),
+	}
+}
+
+func expired(since map[uint64]time.Time, id uint64, active bool, linger time.Duration, now time.Time) bool {
+	if !active {
+		delete(since, id)
+		return false
+	}
+	start, ok := since[id]
+	if !ok {
+		since[id] = now
+		return linger <= 0
+	}
+	return now.Sub(start) >= linger
+}
+
+func (s *Server) collectRooms(now time.Time) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	for id, room := range s.rooms {
+		finished := expired(s.lifecycle.finishedSince, id, room.State() == mafia_connection.State_END, s.lifecycle.finishedLinger, now)
+		empty := expired(s.lifecycle.emptySince, id, room.IsEmpty(), s.lifecycle.emptyLinger, now)
+		if finished || empty {
+			s.closeRoom(room)
+			s.Logger.Info("Room closed", zap.Uint64("id", id), zap.Bool("finished", finished), zap.Bool("empty", empty))
+		}
+	}
+}
+
+func (s *Server) closeRoom(room *game.Room) {
+	for _, user := range room.Close() {
+		s.forgetPlayer(user.ID)
+	}
+	for userID, request := range s.requestedRooms {
+		if request.roomID == room.ID {
+			delete(s.requestedRooms, userID)
+		}
+	}
+	delete(s.lifecycle.finishedSince, room.ID)
+	delete(s.lifecycle.emptySince, room.ID)
+	delete(s.rooms, room.ID)
+}
+
+func (s *Server) runRoomGC(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		s.collectRooms(now)
+		stats := s.roomStats()
+		s.Logger.Debug("Rooms", zap.Uint32("total", stats.Total), zap.Any("states", stats.Counts))
+	}
+}
+
+func (s *Server) roomStats() *mafia_connection.RoomStats {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	stats := &mafia_connection.RoomStats{
+		Counts: make(map[string]uint32),
+		Total:  uint32(len(s.rooms)),
+	}
+	for _, state := range mafia_connection.State_name {
+		stats.Counts[state] = 0
+	}
+	for _, room := range s.rooms {
+		stats.Counts[room.State().String()]++
+	}
+	return stats
+}
+
+func (s *Server) GetRoomStats(ctx context.Context, _ *empty.Empty) (*mafia_connection.RoomStats, error) {
+	return s.roomStats(), nil
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	game "mafia/game"
+	mafia_connection "mafia/protos"
+)
+
+// LoadRulesets reads every YAML or JSON file in dir as a ruleset on top of the
+// base config. A file without a name is named after the file itself.
+func LoadRulesets(dir string, base game.RoomConfig) (map[string]game.RoomConfig, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	rulesets := make(map[string]game.RoomConfig)
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml" && ext != ".json") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		ruleset, err := game.ParseRuleset(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if ruleset.Name == "" {
+			ruleset.Name = strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+		}
+		if _, ok := rulesets[ruleset.Name]; ok {
+			return nil, fmt.Errorf("%s: ruleset '%s' is already defined", path, ruleset.Name)
+		}
+		config, err := ruleset.Apply(base)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		rulesets[ruleset.Name] = config
+	}
+	return rulesets, nil
+}
+
+func (s *Server) ListRulesets(ctx context.Context, _ *empty.Empty) (*mafia_connection.RulesetList, error) {
+	names := make([]string, 0, len(s.rulesets))
+	for name := range s.rulesets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return &mafia_connection.RulesetList{Names: names}, nil
+}
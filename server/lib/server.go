@@ -1,29 +1,124 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"sort"
 	"sync"
+	"time"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	game "mafia/game"
 	mafia_connection "mafia/protos"
+	"mafia/utils"
 )
 
 type Config struct {
-	Port          uint32 `config:"port"`
-	StatsEndpoint string `config:"stats-endpoint"`
-	LogLevel      string `config:"log-level"`
+	Port                 uint32        `config:"port"`
+	StatsEndpoint        string        `config:"stats-endpoint"`
+	LogLevel             string        `config:"log-level"`
+	MinPlayers           uint32        `config:"min-players"`
+	MaxPlayers           uint32        `config:"max-players"`
+	Roles                string        `config:"roles"`
+	DayDuration          time.Duration `config:"day-duration"`
+	NightDuration        time.Duration `config:"night-duration"`
+	SpectatorsSeeRoles   bool          `config:"spectators-see-roles"`
+	ReconnectGracePeriod time.Duration `config:"reconnect-grace-period"`
+	BotWait              time.Duration `config:"bot-wait"`
+	BotStrategy          string        `config:"bot-strategy"`
+	TieBreak             string        `config:"tie-break"`
+	LastWordsDuration    time.Duration `config:"last-words-duration"`
+	Rules                string        `config:"rules"`
+	SpeechDuration       time.Duration `config:"speech-duration"`
+	RevealOnDeath        string        `config:"reveal-on-death"`
+	RulesDir             string        `config:"rules-dir"`
+	MafiaVote            string        `config:"mafia-vote"`
+	ConsensusFallback    string        `config:"consensus-fallback"`
+	RematchWait          time.Duration `config:"rematch-wait"`
+	SnapshotFile         string        `config:"snapshot-file"`
+	SnapshotInterval     time.Duration `config:"snapshot-interval"`
+	RoomLinger           time.Duration `config:"room-linger"`
+	EmptyRoomLinger      time.Duration `config:"empty-room-linger"`
+	RoomGCInterval       time.Duration `config:"room-gc-interval"`
+}
+
+func (cfg *Config) RoomConfig() (game.RoomConfig, error) {
+	roles, err := game.ParseRoles(cfg.Roles)
+	if err != nil {
+		return game.RoomConfig{}, err
+	}
+	tieBreak, err := game.ParseTieBreak(cfg.TieBreak)
+	if err != nil {
+		return game.RoomConfig{}, err
+	}
+	rules, err := game.ParseRules(cfg.Rules)
+	if err != nil {
+		return game.RoomConfig{}, err
+	}
+	mafiaVote, err := game.ParseMafiaVote(cfg.MafiaVote)
+	if err != nil {
+		return game.RoomConfig{}, err
+	}
+	fallback, err := game.ParseConsensusFallback(cfg.ConsensusFallback)
+	if err != nil {
+		return game.RoomConfig{}, err
+	}
+	revealOnDeath, err := game.ParseRevealPolicy(cfg.RevealOnDeath)
+	if err != nil {
+		return game.RoomConfig{}, err
+	}
+	roomConfig := game.RoomConfig{
+		MinPlayers:              cfg.MinPlayers,
+		MaxPlayers:              cfg.MaxPlayers,
+		Roles:                   roles,
+		DayDuration:             cfg.DayDuration,
+		NightDuration:           cfg.NightDuration,
+		RevealRolesToSpectators: cfg.SpectatorsSeeRoles,
+		BotWait:                 cfg.BotWait,
+		BotStrategy:             cfg.BotStrategy,
+		TieBreak:                tieBreak,
+		LastWordsDuration:       cfg.LastWordsDuration,
+		SpeechDuration:          cfg.SpeechDuration,
+		RevealOnDeath:           revealOnDeath,
+		Chat:                    game.AllChats(),
+		MafiaVote:               mafiaVote,
+		ConsensusFallback:       fallback,
+		RematchWait:             cfg.RematchWait,
+	}
+	roomConfig.ApplyRules(rules)
+	if err := roomConfig.Validate(); err != nil {
+		return game.RoomConfig{}, err
+	}
+	return roomConfig, nil
+}
+
+type joinRequest struct {
+	roomID     uint64
+	inviteCode string
+	spectate   bool
 }
 
 type Server struct {
 	playersToRooms map[uint64]uint64
+	requestedRooms map[uint64]joinRequest
+	sessions       map[uint64]string
+	restored       map[uint64]bool
 	rooms          map[uint64]*game.Room
+	archive        *game.Archive
 	Logger         *zap.Logger
 	mux            sync.Mutex
 	statsEndpoint  string
+	roomConfig     game.RoomConfig
+	rulesets       map[string]game.RoomConfig
+	gracePeriod    time.Duration
+	snapshotFile   string
+	lifecycle      *roomLifecycle
 
 	mafia_connection.UnimplementedMafiaServiceServer
 }
@@ -61,43 +156,334 @@ func InitServer(cfg *Config) (*Server, error) {
 		return nil, err
 	}
 
-	return &Server{
+	roomConfig, err := cfg.RoomConfig()
+	if err != nil {
+		logger.Error("Invalid room config", zap.Error(err))
+		return nil, err
+	}
+
+	s := &Server{
 		playersToRooms: make(map[uint64]uint64),
+		requestedRooms: make(map[uint64]joinRequest),
+		sessions:       make(map[uint64]string),
+		restored:       make(map[uint64]bool),
 		rooms:          make(map[uint64]*game.Room),
+		archive:        game.NewArchive(),
 		mux:            sync.Mutex{},
 		statsEndpoint:  cfg.StatsEndpoint,
+		roomConfig:     roomConfig,
+		rulesets:       make(map[string]game.RoomConfig),
+		gracePeriod:    cfg.ReconnectGracePeriod,
+		snapshotFile:   cfg.SnapshotFile,
+		lifecycle:      newRoomLifecycle(cfg.RoomLinger, cfg.EmptyRoomLinger),
 		Logger:         logger,
-	}, nil
+	}
+	if cfg.RulesDir != "" {
+		s.rulesets, err = LoadRulesets(cfg.RulesDir, roomConfig)
+		if err != nil {
+			logger.Error("Invalid ruleset", zap.String("dir", cfg.RulesDir), zap.Error(err))
+			return nil, err
+		}
+		logger.Info("Rulesets loaded", zap.Int("count", len(s.rulesets)))
+	}
+	if s.snapshotFile != "" {
+		if err := s.LoadSnapshot(); err != nil {
+			logger.Error("Failed to load snapshot", zap.String("file", s.snapshotFile), zap.Error(err))
+			return nil, err
+		}
+		if cfg.SnapshotInterval > 0 {
+			go s.runSnapshots(cfg.SnapshotInterval)
+		}
+	}
+	if cfg.RoomGCInterval > 0 {
+		go s.runRoomGC(cfg.RoomGCInterval)
+	}
+	return s, nil
 }
 
-func (s *Server) AddPlayer(user *mafia_connection.User, stream mafia_connection.MafiaService_RouteGameServer) uint64 {
+func (s *Server) AddPlayer(user *mafia_connection.User, stream mafia_connection.MafiaService_RouteGameServer) (*game.Room, error) {
 	s.mux.Lock()
 	defer s.mux.Unlock()
-	id, ok := s.playersToRooms[user.ID]
+	_, ok := s.playersToRooms[user.ID]
 	if ok {
-		return id
+		return nil, errAlreadyInRoom
 	}
-	for id := range s.rooms {
-		if s.rooms[id].TryToAddPlayer(user, stream) {
+	request, ok := s.requestedRooms[user.ID]
+	if ok {
+		delete(s.requestedRooms, user.ID)
+		room, ok := s.rooms[request.roomID]
+		if !ok {
+			return nil, errRoomNotFound
+		}
+		if request.spectate {
+			if !room.TryToAddSpectator(user, stream, request.inviteCode) {
+				return nil, errRoomIsNotAvailable
+			}
+		} else if !room.TryToAddPlayer(user, stream, request.inviteCode) {
+			return nil, errRoomIsNotAvailable
+		}
+		s.playersToRooms[user.ID] = request.roomID
+		return room, nil
+	}
+	for id, room := range s.rooms {
+		if room.TryToAddPlayer(user, stream, "") {
 			s.playersToRooms[user.ID] = id
-			return id
+			return room, nil
 		}
 	}
-	room := game.GetNewRoom(s.statsEndpoint)
-	s.rooms[room.ID] = room
-	room.TryToAddPlayer(user, stream)
+	room := s.newRoom("", s.roomConfig)
+	s.Logger.Info("Room created", zap.Uint64("id", room.ID), zap.String("name", room.Name), zap.Int64("seed", room.Seed()))
+	room.TryToAddPlayer(user, stream, "")
 	s.playersToRooms[user.ID] = room.ID
-	return room.ID
+	return room, nil
+}
+
+func (s *Server) ListRooms(ctx context.Context, _ *empty.Empty) (*mafia_connection.RoomList, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	rooms := make([]*mafia_connection.RoomDescription, 0, len(s.rooms))
+	for _, room := range s.rooms {
+		description := room.Describe()
+		if description.State != mafia_connection.State_END && !description.Private {
+			rooms = append(rooms, description)
+		}
+	}
+	sort.Slice(rooms, func(i, j int) bool { return rooms[i].Name < rooms[j].Name })
+	return &mafia_connection.RoomList{Rooms: rooms}, nil
+}
+
+func (s *Server) CreateRoom(ctx context.Context, req *mafia_connection.CreateRoomRequest) (*mafia_connection.RoomDescription, error) {
+	if !utils.ValidateRoomName(req.Name) {
+		return nil, status.Error(codes.InvalidArgument, utils.GetErrorMessageForRoomName(req.Name))
+	}
+	config := s.roomConfig
+	if req.Ruleset != "" {
+		ruleset, ok := s.rulesets[req.Ruleset]
+		if !ok {
+			return nil, errRulesetNotFound
+		}
+		config = ruleset
+	}
+	if req.TieBreak != "" {
+		tieBreak, err := game.ParseTieBreak(req.TieBreak)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		config.TieBreak = tieBreak
+	}
+	if req.RevealOnDeath != "" {
+		revealOnDeath, err := game.ParseRevealPolicy(req.RevealOnDeath)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		config.RevealOnDeath = revealOnDeath
+	}
+	if req.Rules != "" {
+		rules, err := game.ParseRules(req.Rules)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		config.ApplyRules(rules)
+		if err := config.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	room := s.newRoom(req.Name, config)
+	if req.Private {
+		room.MakePrivate(s.generateInviteCode())
+	}
+	s.Logger.Info("Room created", zap.Uint64("id", room.ID), zap.String("name", room.Name), zap.Bool("private", req.Private), zap.String("tie-break", config.TieBreak.String()), zap.String("rules", config.Rules.String()), zap.String("ruleset", config.Ruleset), zap.String("reveal-on-death", config.RevealOnDeath.String()), zap.Int64("seed", room.Seed()))
+	description := room.Describe()
+	description.InviteCode = room.InviteCode()
+	return description, nil
+}
+
+func (s *Server) ReplayGame(req *mafia_connection.ReplayRequest, stream mafia_connection.MafiaService_ReplayGameServer) error {
+	events, ok := s.archive.Get(req.GameID)
+	if !ok {
+		return errGameNotFound
+	}
+	for _, event := range events {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) newRoom(name string, config game.RoomConfig) *game.Room {
+	for {
+		room := game.GetNewRoom(name, s.statsEndpoint, config, s.archive, time.Now().UnixNano())
+		if _, ok := s.rooms[room.ID]; !ok {
+			s.rooms[room.ID] = room
+			room.OnRematch(s.rematchStarted)
+			return room
+		}
+	}
+}
+
+// rematchStarted is called with the room locked, so the players are released
+// in the background to keep the lock order of the server and the rooms.
+func (s *Server) rematchStarted(room *game.Room, released []*mafia_connection.User) {
+	go func() {
+		s.mux.Lock()
+		defer s.mux.Unlock()
+		for _, user := range released {
+			if s.playersToRooms[user.ID] == room.ID {
+				s.forgetPlayer(user.ID)
+			}
+		}
+		s.Logger.Info("Rematch started", zap.Uint64("id", room.ID), zap.Int("released", len(released)), zap.Int64("seed", room.Seed()))
+	}()
+}
+
+func (s *Server) generateInviteCode() string {
+	for {
+		code := utils.GenerateInviteCode()
+		if s.findRoomByInviteCode(code) == nil {
+			return code
+		}
+	}
+}
+
+func (s *Server) findRoomByInviteCode(code string) *game.Room {
+	for _, room := range s.rooms {
+		if room.IsPrivate() && room.InviteCode() == code {
+			return room
+		}
+	}
+	return nil
+}
+
+func (s *Server) JoinRoom(ctx context.Context, req *mafia_connection.JoinRoomRequest) (*mafia_connection.RoomDescription, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	room, ok := s.rooms[req.RoomID]
+	if req.RoomID == 0 && req.InviteCode != "" {
+		room = s.findRoomByInviteCode(req.InviteCode)
+		ok = room != nil
+	}
+	if !ok {
+		return nil, errRoomNotFound
+	}
+	if room.IsPrivate() && room.InviteCode() != req.InviteCode {
+		return nil, errWrongInviteCode
+	}
+	if req.Spectate && !room.CanSpectate(req.InviteCode) {
+		return nil, errRoomIsFinished
+	}
+	if !req.Spectate && !room.CanJoin(req.InviteCode) {
+		return nil, errRoomIsNotAvailable
+	}
+	s.requestedRooms[req.User.ID] = joinRequest{
+		roomID:     room.ID,
+		inviteCode: req.InviteCode,
+		spectate:   req.Spectate,
+	}
+	return room.Describe(), nil
 }
 
 func (s *Server) RemovePlayer(user *mafia_connection.User) {
 	s.mux.Lock()
 	defer s.mux.Unlock()
+	s.removePlayer(user)
+}
+
+func (s *Server) removePlayer(user *mafia_connection.User) {
 	id, ok := s.playersToRooms[user.ID]
-	if ok {
-		s.rooms[id].LeaveRoom(user)
+	if room, exists := s.rooms[id]; ok && exists {
+		room.LeaveRoom(user)
+	}
+	s.forgetPlayer(user.ID)
+}
+
+func (s *Server) forgetPlayer(id uint64) {
+	delete(s.playersToRooms, id)
+	delete(s.sessions, id)
+	delete(s.restored, id)
+}
+
+func (s *Server) KickPlayer(room *game.Room, author *mafia_connection.User, target *mafia_connection.User) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if room.KickRequest(author, target) {
+		s.forgetPlayer(target.ID)
+		s.Logger.Info("Player kicked", zap.String("nickname", target.Nickname), zap.Uint64("room", room.ID))
+	}
+}
+
+func (s *Server) DisconnectPlayer(user *mafia_connection.User, stream mafia_connection.MafiaService_RouteGameServer) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	id, ok := s.playersToRooms[user.ID]
+	if !ok {
+		return
+	}
+	room := s.rooms[id]
+	if s.gracePeriod <= 0 || !room.Disconnect(user, stream) {
+		s.removePlayer(user)
+		return
 	}
-	delete(s.playersToRooms, user.ID)
+	s.Logger.Info("Player disconnected", zap.String("nickname", user.Nickname), zap.Uint64("room", id))
+	s.removeAfterGracePeriod(user, id)
+}
+
+func (s *Server) removeAfterGracePeriod(user *mafia_connection.User, id uint64) {
+	room := s.rooms[id]
+	time.AfterFunc(s.gracePeriod, func() {
+		s.mux.Lock()
+		defer s.mux.Unlock()
+		if s.playersToRooms[user.ID] == id && room.IsDisconnected(user) {
+			s.Logger.Info("Reconnect grace period expired", zap.String("nickname", user.Nickname), zap.Uint64("room", id))
+			s.removePlayer(user)
+		}
+	})
+}
+
+func (s *Server) issueSession(user *mafia_connection.User) string {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	token := utils.GenerateSessionToken()
+	s.sessions[user.ID] = token
+	return token
+}
+
+func (s *Server) ReconnectPlayer(request *mafia_connection.ReconnectRequest, stream mafia_connection.MafiaService_RouteGameServer) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	token, ok := s.sessions[request.User.ID]
+	if !ok || token != request.Session {
+		return errInvalidSession
+	}
+	id, ok := s.playersToRooms[request.User.ID]
+	if !ok || !s.rooms[id].Reconnect(request.User, stream) {
+		return errInvalidSession
+	}
+	delete(s.restored, request.User.ID)
+	return nil
+}
+
+func (s *Server) roomOf(user *mafia_connection.User) *game.Room {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if user == nil {
+		return nil
+	}
+	id, ok := s.playersToRooms[user.ID]
+	if !ok {
+		return nil
+	}
+	return s.rooms[id]
+}
+
+func sendError(stream mafia_connection.MafiaService_RouteGameServer, err error) {
+	stream.Send(&mafia_connection.ServerAction{
+		Action: &mafia_connection.ServerAction_ServerMessage{
+			ServerMessage: status.Convert(err).Message(),
+		},
+	})
 }
 
 func (s *Server) HandlePlayersActions(
@@ -116,7 +502,11 @@ func (s *Server) HandlePlayersActions(
 			playerAction, err := stream.Recv()
 			if err != nil {
 				if curUserData != nil {
-					s.RemovePlayer(curUserData)
+					if err == io.EOF {
+						s.RemovePlayer(curUserData)
+					} else {
+						s.DisconnectPlayer(curUserData, stream)
+					}
 				}
 				errChan <- err
 				return
@@ -125,20 +515,79 @@ func (s *Server) HandlePlayersActions(
 			switch {
 			case playerAction.GetConnetion() != nil:
 				user := playerAction.GetConnetion()
+				if room := s.reconnectRestored(user, stream); room != nil {
+					curUserData = user
+					room.SendSession(user, s.issueSession(user))
+					continue
+				}
+				room, err := s.AddPlayer(user, stream)
+				if err != nil {
+					sendError(stream, err)
+					errChan <- err
+					return
+				}
 				curUserData = user
-				id := s.AddPlayer(user, stream)
-				s.rooms[id].JoinRoom(user)
-			case playerAction.GetVote() != nil:
-				roomId := s.playersToRooms[curUserData.ID]
-				s.rooms[roomId].VoteRequest(curUserData, playerAction.GetVote())
-			case playerAction.GetShow() != nil:
-				roomId := s.playersToRooms[curUserData.ID]
-				s.rooms[roomId].ShowRequest(curUserData, playerAction.GetShow())
+				room.JoinRoom(user)
+				room.SendSession(user, s.issueSession(user))
+			case playerAction.GetReconnect() != nil:
+				request := playerAction.GetReconnect()
+				if err := s.ReconnectPlayer(request, stream); err != nil {
+					sendError(stream, err)
+					errChan <- err
+					return
+				}
+				curUserData = request.User
+			default:
+				s.inRoom(curUserData, stream, func(room *game.Room) {
+					s.roomRequest(room, curUserData, playerAction)
+				})
 			}
 		}
 	}
 }
 
+// inRoom runs the request on the room of the user, or tells the user that there
+// is no such room.
+func (s *Server) inRoom(user *mafia_connection.User, stream mafia_connection.MafiaService_RouteGameServer, request func(room *game.Room)) {
+	room := s.roomOf(user)
+	if room == nil {
+		sendError(stream, errRoomNotFound)
+		return
+	}
+	request(room)
+}
+
+func (s *Server) roomRequest(room *game.Room, user *mafia_connection.User, playerAction *mafia_connection.PlayerAction) {
+	switch action := playerAction.Action.(type) {
+	case *mafia_connection.PlayerAction_Vote:
+		room.VoteRequest(user, action.Vote)
+	case *mafia_connection.PlayerAction_Show:
+		room.ShowRequest(user, action.Show)
+	case *mafia_connection.PlayerAction_Heal:
+		room.HealRequest(user, action.Heal)
+	case *mafia_connection.PlayerAction_Start:
+		room.StartRequest(user)
+	case *mafia_connection.PlayerAction_Abstain:
+		room.AbstainRequest(user)
+	case *mafia_connection.PlayerAction_Retract:
+		room.RetractRequest(user)
+	case *mafia_connection.PlayerAction_Rematch:
+		room.RematchRequest(user)
+	case *mafia_connection.PlayerAction_Kick:
+		s.KickPlayer(room, user, action.Kick)
+	case *mafia_connection.PlayerAction_Check:
+		room.CheckRequest(user, action.Check)
+	case *mafia_connection.PlayerAction_Nominate:
+		room.NominateRequest(user, action.Nominate)
+	case *mafia_connection.PlayerAction_EndSpeech:
+		room.EndSpeechRequest(user)
+	case *mafia_connection.PlayerAction_Ready:
+		room.ReadyRequest(user, action.Ready)
+	case *mafia_connection.PlayerAction_LastWords:
+		room.LastWordsRequest(user, action.LastWords)
+	}
+}
+
 func (s *Server) RouteGame(stream mafia_connection.MafiaService_RouteGameServer) error {
 	stopJobs := make(chan bool)
 	errChan := make(chan error)
@@ -157,3 +606,14 @@ func (s *Server) RouteGame(stream mafia_connection.MafiaService_RouteGameServer)
 	s.Logger.Error("route", zap.Error(err))
 	return err
 }
+
+var (
+	errRoomNotFound       = status.Error(codes.NotFound, "room not found")
+	errRoomIsNotAvailable = status.Error(codes.FailedPrecondition, "room is full or the game has already started")
+	errWrongInviteCode    = status.Error(codes.PermissionDenied, "wrong invite code")
+	errRoomIsFinished     = status.Error(codes.FailedPrecondition, "game in the room has already finished")
+	errAlreadyInRoom      = status.Error(codes.AlreadyExists, "player with this nickname is already in a room")
+	errInvalidSession     = status.Error(codes.Unauthenticated, "session is expired or invalid")
+	errGameNotFound       = status.Error(codes.NotFound, "finished game not found")
+	errRulesetNotFound    = status.Error(codes.InvalidArgument, "ruleset not found")
+)
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+
+	game "mafia/game"
+	mafia_connection "mafia/protos"
+)
+
+type snapshot struct {
+	Rooms    []*game.RoomSnapshot                     `json:"rooms"`
+	Sessions map[uint64]string                        `json:"sessions"`
+	Games    map[uint64][]*mafia_connection.GameEvent `json:"games"`
+}
+
+func (s *Server) SaveSnapshot() error {
+	s.mux.Lock()
+	data := snapshot{
+		Rooms:    make([]*game.RoomSnapshot, 0, len(s.rooms)),
+		Sessions: make(map[uint64]string, len(s.sessions)),
+		Games:    s.archive.All(),
+	}
+	for _, room := range s.rooms {
+		data.Rooms = append(data.Rooms, room.Snapshot())
+	}
+	for id, token := range s.sessions {
+		data.Sessions[id] = token
+	}
+	s.mux.Unlock()
+
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	tmp := s.snapshotFile + ".tmp"
+	if err := os.WriteFile(tmp, bytes, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.snapshotFile)
+}
+
+func (s *Server) LoadSnapshot() error {
+	bytes, err := os.ReadFile(s.snapshotFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var data snapshot
+	if err := json.Unmarshal(bytes, &data); err != nil {
+		return err
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	for id, events := range data.Games {
+		s.archive.Save(id, events)
+	}
+	for _, roomSnapshot := range data.Rooms {
+		room := game.RestoreRoom(roomSnapshot, s.statsEndpoint, s.archive)
+		s.rooms[room.ID] = room
+		room.OnRematch(s.rematchStarted)
+		for _, user := range room.HumanPlayers() {
+			s.playersToRooms[user.ID] = room.ID
+			s.restored[user.ID] = true
+			if token, ok := data.Sessions[user.ID]; ok {
+				s.sessions[user.ID] = token
+			}
+			if s.gracePeriod > 0 {
+				s.removeAfterGracePeriod(user, room.ID)
+			}
+		}
+		s.Logger.Info("Room restored", zap.Uint64("id", room.ID), zap.String("name", room.Name), zap.Int64("seed", room.Seed()))
+	}
+	return nil
+}
+
+func (s *Server) runSnapshots(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := s.SaveSnapshot(); err != nil {
+			s.Logger.Error("Failed to save snapshot", zap.Error(err))
+		}
+	}
+}
+
+func (s *Server) reconnectRestored(user *mafia_connection.User, stream mafia_connection.MafiaService_RouteGameServer) *game.Room {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if !s.restored[user.ID] {
+		return nil
+	}
+	id, ok := s.playersToRooms[user.ID]
+	if !ok || !s.rooms[id].Reconnect(user, stream) {
+		return nil
+	}
+	delete(s.restored, user.ID)
+	return s.rooms[id]
+}
@@ -20,9 +20,29 @@ import (
 func main() {
 	rand.Seed(time.Now().UnixNano())
 	cfg := server.Config{
-		Port:          5050,
-		StatsEndpoint: "http://[::]:6669/push",
-		LogLevel:      "info",
+		Port:                 5050,
+		StatsEndpoint:        "http://[::]:6669/push",
+		LogLevel:             "info",
+		MinPlayers:           4,
+		MaxPlayers:           4,
+		Roles:                "MAFIA:1,SHERIFF:1",
+		DayDuration:          3 * time.Minute,
+		NightDuration:        time.Minute,
+		ReconnectGracePeriod: time.Minute,
+		BotStrategy:          "heuristic",
+		TieBreak:             "random",
+		LastWordsDuration:    30 * time.Second,
+		Rules:                "casual",
+		SpeechDuration:       time.Minute,
+		RevealOnDeath:        "none",
+		MafiaVote:            "plurality",
+		ConsensusFallback:    "plurality",
+		RematchWait:          30 * time.Second,
+		SnapshotFile:         "rooms.json",
+		SnapshotInterval:     10 * time.Second,
+		RoomLinger:           5 * time.Minute,
+		EmptyRoomLinger:      time.Minute,
+		RoomGCInterval:       30 * time.Second,
 	}
 
 	err := confita.NewLoader(
@@ -5,6 +5,8 @@ package model
 type Game struct {
 	ID       string   `json:"id"`
 	User     []*User  `json:"user"`
+	Winner   string   `json:"winner"`
+	Seed     string   `json:"seed"`
 	Comments []string `json:"comments"`
 }
 
@@ -17,4 +19,5 @@ type User struct {
 	Nickname string `json:"nickname"`
 	IsWinner bool   `json:"isWinner"`
 	Role     string `json:"role"`
+	IsBot    bool   `json:"isBot"`
 }
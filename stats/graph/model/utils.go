@@ -12,11 +12,14 @@ func ConvertGameInfo(game *storage.GameInfo) *Game {
 			Nickname: player.Nickname,
 			IsWinner: player.IsWinner,
 			Role:     player.Role,
+			IsBot:    player.IsBot,
 		})
 	}
 	return &Game{
 		ID:       strconv.FormatUint(game.Id, 10),
 		User:     users,
+		Winner:   game.Winner,
+		Seed:     strconv.FormatInt(game.Seed, 10),
 		Comments: game.Comments,
 	}
 }
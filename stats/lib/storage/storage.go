@@ -26,12 +26,15 @@ type Player struct {
 	Nickname string `json:"nickname"`
 	IsWinner bool   `json:"isWinner"`
 	Role     string `json:"role"`
+	IsBot    bool   `json:"isBot"`
 }
 
 type GameInfo struct {
 	Id       uint64   `json:"id"`
 	Duration int64    `json:"duration"`
 	Players  []Player `json:"players"`
+	Winner   string   `json:"winner"`
+	Seed     int64    `json:"seed"`
 	Comments []string `json:"comments"`
 }
 
@@ -88,6 +91,9 @@ func (s *Storage) SaveGameResult(game *GameInfo) error {
 
 	s.games[game.Id] = game
 	for _, player := range game.Players {
+		if player.IsBot {
+			continue
+		}
 		user := s.getOrCreateUser(player.Nickname)
 		user.GamesCnt++
 		if player.IsWinner {
@@ -1,8 +1,11 @@
 package utils
 
 import (
+	crand "crypto/rand"
+	"encoding/hex"
 	"hash/fnv"
 	"math/rand"
+	"strings"
 	"unicode"
 )
 
@@ -13,15 +16,44 @@ func NicknameHash(nick string) uint64 {
 }
 
 func GenerateNickname() string {
+	return GenerateNicknameWith(rand.New(rand.NewSource(rand.Int63())))
+}
+
+func GenerateNicknameWith(rng *rand.Rand) string {
 	const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
-	l := rand.Intn(12) + 4
+	l := rng.Intn(12) + 4
 	b := make([]byte, l)
 	for i := range b {
-		b[i] = letters[rand.Intn(len(letters))]
+		b[i] = letters[rng.Intn(len(letters))]
+	}
+	return string(b)
+}
+
+func GenerateInviteCode() string {
+	const symbols = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	b := make([]byte, 6)
+	if _, err := crand.Read(b); err != nil {
+		for i := range b {
+			b[i] = byte(rand.Intn(256))
+		}
+	}
+	// 256 is a multiple of len(symbols), so every symbol is equally likely
+	for i := range b {
+		b[i] = symbols[int(b[i])%len(symbols)]
 	}
 	return string(b)
 }
 
+func GenerateSessionToken() string {
+	b := make([]byte, 16)
+	if _, err := crand.Read(b); err != nil {
+		for i := range b {
+			b[i] = byte(rand.Intn(256))
+		}
+	}
+	return hex.EncodeToString(b)
+}
+
 func ValidateNicknameImpl(nick string) string {
 	if len(nick) < 4 || len(nick) > 15 {
 		return "Nickname must be at least 4 characters and no longer than 15"
@@ -42,17 +74,33 @@ func GetErrorMessageForNickname(nick string) string {
 	return ValidateNicknameImpl(nick)
 }
 
-func GetRandomMaximumIndex(arr []int) int {
-	max := arr[0]
+func ValidateRoomNameImpl(name string) string {
+	if len(strings.TrimSpace(name)) == 0 || len(name) > 30 {
+		return "Room name must not be empty and no longer than 30 characters"
+	}
+	return ""
+}
+
+func ValidateRoomName(name string) bool {
+	return ValidateRoomNameImpl(name) == ""
+}
+
+func GetErrorMessageForRoomName(name string) string {
+	return ValidateRoomNameImpl(name)
+}
+
+func GetMaximumIndices(arr []int) []int {
 	maxIds := make([]int, 0)
+	max := 0
 	for i, val := range arr {
+		if val <= 0 || val < max {
+			continue
+		}
 		if val > max {
 			max = val
 			maxIds = make([]int, 0)
 		}
-		if val == max {
-			maxIds = append(maxIds, i)
-		}
+		maxIds = append(maxIds, i)
 	}
-	return maxIds[rand.Intn(len(maxIds))]
+	return maxIds
 }